@@ -6,8 +6,12 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	_ "github.com/joho/godotenv/autoload"
+	"github.com/waliqueiroz/letmeask-api/internal/application/policies"
+	"github.com/waliqueiroz/letmeask-api/internal/application/providers"
 	"github.com/waliqueiroz/letmeask-api/internal/application/services"
 	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/authentication/jwt"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/authentication/opaque"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/broadcasting/hub"
 	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/configurations/env"
 	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/database/mongodb"
 	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/database/mongodb/repositories"
@@ -15,7 +19,15 @@ import (
 	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/http/fiber/errors"
 	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/http/fiber/middlewares"
 	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/http/fiber/routes"
+	mailMemory "github.com/waliqueiroz/letmeask-api/internal/infrastructure/mail/memory"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/mail/smtp"
+	rateLimitMemory "github.com/waliqueiroz/letmeask-api/internal/infrastructure/ratelimit/memory"
+	rateLimitMongo "github.com/waliqueiroz/letmeask-api/internal/infrastructure/ratelimit/mongodb"
+	revocationMemory "github.com/waliqueiroz/letmeask-api/internal/infrastructure/revocation/memory"
+	revocationMongo "github.com/waliqueiroz/letmeask-api/internal/infrastructure/revocation/mongodb"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/scheduling/ticker"
 	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/security/bcrypt"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/security/hmac"
 	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/validation/goplayground"
 )
 
@@ -28,34 +40,114 @@ func main() {
 		log.Fatalln(err)
 	}
 
+	err = mongodb.CreateIndexes(db)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
 	authProvider := jwt.NewJwtProvider(configuration)
+	tokenProvider := opaque.NewOpaqueTokenProvider()
 	securityProvider := bcrypt.NewBcryptProvider()
+	signatureProvider := hmac.NewHmacProvider(configuration)
 	validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+	broadcastProvider := hub.NewHubProvider()
+
+	var revocationStore providers.RevocationStore
+	switch configuration.Auth.RevocationStore {
+	case "memory":
+		revocationStore = revocationMemory.NewMemoryRevocationStore()
+	case "mongodb":
+		revocationStore = revocationMongo.NewMongoRevocationStore(db)
+	default:
+		log.Fatalf("unknown revocation store %q", configuration.Auth.RevocationStore)
+	}
+
+	var rateLimiter providers.RateLimiter
+	switch configuration.RateLimit.Store {
+	case "memory":
+		rateLimiter = rateLimitMemory.NewMemoryRateLimiter()
+	case "mongodb":
+		rateLimiter = rateLimitMongo.NewMongoRateLimiter(db)
+	default:
+		log.Fatalf("unknown rate limit store %q", configuration.RateLimit.Store)
+	}
+
+	var mailProvider providers.Mailer
+	switch configuration.Mail.Driver {
+	case "memory":
+		mailProvider = mailMemory.NewMemoryProvider()
+	case "smtp":
+		mailProvider = smtp.NewSMTPProvider(configuration)
+	default:
+		log.Fatalf("unknown mail driver %q", configuration.Mail.Driver)
+	}
+
+	userPolicy := policies.NewUserPolicy()
+	roomPolicy := policies.NewRoomPolicy(configuration.Auth.RequireVerifiedEmail)
 
 	userRepository := repositories.NewUserRepository(db)
-	userService := services.NewUserService(userRepository, securityProvider)
-	userController := controllers.NewUserController(userService, validationProvider)
+	userService := services.NewUserService(userRepository, securityProvider, userPolicy, signatureProvider, mailProvider, services.UserConfig{
+		VerificationTTL: configuration.Auth.VerificationTTL,
+		VerificationURL: configuration.Auth.VerificationURL,
+	})
+	userController := controllers.NewUserController(userService, authProvider, validationProvider)
 
-	authService := services.NewAuthService(userRepository, securityProvider, authProvider)
-	authController := controllers.NewAuthController(authService, validationProvider)
+	sessionRepository := repositories.NewSessionRepository(db)
+	authService := services.NewAuthService(userRepository, sessionRepository, securityProvider, authProvider, tokenProvider, revocationStore, services.AuthConfig{
+		AccessTokenTTL:     configuration.Auth.AccessTokenTTL,
+		RefreshTokenTTL:    configuration.Auth.RefreshTokenTTL,
+		LockoutThreshold:   configuration.Auth.LockoutThreshold,
+		LockoutDuration:    configuration.Auth.LockoutDuration,
+		MaxLockoutDuration: configuration.Auth.MaxLockoutDuration,
+		FailureWindow:      configuration.Auth.FailureWindow,
+	})
+	authController := controllers.NewAuthController(authService, authProvider, validationProvider)
+
+	passwordResetRepository := repositories.NewPasswordResetRepository(db)
+	passwordService := services.NewPasswordService(userRepository, passwordResetRepository, securityProvider, tokenProvider, mailProvider, services.PasswordConfig{
+		ResetTokenTTL: configuration.Auth.ResetTokenTTL,
+		ResetURL:      configuration.Auth.ResetURL,
+	})
+	passwordController := controllers.NewPasswordController(passwordService, validationProvider)
 
 	roomRepository := repositories.NewRoomRepository(db)
-	roomService := services.NewRoomService(roomRepository)
-	roomController := controllers.NewRoomController(roomService, authProvider, validationProvider)
+	roomService := services.NewRoomService(roomRepository, userRepository, securityProvider, signatureProvider, broadcastProvider, roomPolicy, services.RoomConfig{
+		QuestionEditWindow: configuration.Room.QuestionEditWindow,
+		AnswerGracePeriod:  configuration.Room.AnswerGracePeriod,
+		AccessGrantTTL:     configuration.Room.AccessGrantTTL,
+	})
+	roomController := controllers.NewRoomController(roomService, authProvider, broadcastProvider, validationProvider)
+
+	roomScheduler := ticker.NewRoomScheduler(configuration, roomService)
+	roomScheduler.Start()
 
-	authMiddleware := middlewares.NewAuthMiddleware(configuration)
+	authMiddleware := middlewares.NewAuthMiddleware(configuration, authProvider, authService)
+	optionalAuthMiddleware := middlewares.NewOptionalAuthMiddleware(configuration, authProvider, authService)
+	streamAuthMiddleware := middlewares.NewStreamAuthMiddleware(configuration, authProvider, authService)
+	rateLimitMiddleware := middlewares.NewRateLimitMiddleware(rateLimiter, providers.RateLimit{
+		Requests: configuration.RateLimit.Requests,
+		Period:   configuration.RateLimit.Period,
+	})
+	loginRateLimitMiddleware := middlewares.NewLoginRateLimitMiddleware(rateLimiter, providers.RateLimit{
+		Requests: configuration.RateLimit.LoginRequests,
+		Period:   configuration.RateLimit.LoginPeriod,
+	})
 
 	app := fiber.New(fiber.Config{
 		ErrorHandler: errors.Handler,
+		// Behind a proxy every request comes from the proxy itself, so the
+		// address of the client has to be read from the header it sets.
+		ProxyHeader: configuration.RateLimit.ProxyHeader,
 	})
 
 	app.Use(cors.New())
 
-	api := app.Group("/api")
+	api := app.Group("/api", rateLimitMiddleware)
 
-	routes.SetupAuthRoutes(api, authController)
+	routes.SetupAuthRoutes(api, authMiddleware, loginRateLimitMiddleware, authController)
+	routes.SetupPasswordRoutes(api, passwordController)
 	routes.SetupUserRoutes(api, authMiddleware, userController)
-	routes.SetupRoomRoutes(api, authMiddleware, roomController)
+	routes.SetupRoomRoutes(api, authMiddleware, optionalAuthMiddleware, streamAuthMiddleware, roomController)
 
 	app.Listen(":8080")
 }
@@ -0,0 +1,5 @@
+package dtos
+
+type EditQuestionDTO struct {
+	Content string `json:"content" validate:"required"`
+}
@@ -0,0 +1,7 @@
+package dtos
+
+type MailDTO struct {
+	To      string
+	Subject string
+	Body    string
+}
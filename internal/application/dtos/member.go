@@ -0,0 +1,6 @@
+package dtos
+
+type MemberDTO struct {
+	UserID string `json:"user_id" validate:"required"`
+	Role   string `json:"role" validate:"required,oneof=co_host moderator attendee"`
+}
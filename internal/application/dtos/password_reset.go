@@ -0,0 +1,10 @@
+package dtos
+
+type ForgotPasswordDTO struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ResetPasswordDTO struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required"`
+}
@@ -0,0 +1,11 @@
+package dtos
+
+type PollDTO struct {
+	Question       string   `json:"question" validate:"required"`
+	Options        []string `json:"options" validate:"required,min=2,max=10,dive,required"`
+	MultipleChoice bool     `json:"multiple_choice"`
+}
+
+type VoteDTO struct {
+	OptionIDs []string `json:"option_ids" validate:"required,min=1,dive,required"`
+}
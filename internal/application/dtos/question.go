@@ -0,0 +1,6 @@
+package dtos
+
+type QuestionDTO struct {
+	Content     string `json:"content" validate:"required"`
+	IsAnonymous bool   `json:"is_anonymous"`
+}
@@ -0,0 +1,16 @@
+package dtos
+
+import "github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+
+type QuestionQueryDTO struct {
+	Order         string `query:"order" validate:"omitempty,oneof=newest oldest most_liked highlighted_first unanswered_first"`
+	IsAnswered    *bool  `query:"is_answered"`
+	IsHighlighted *bool  `query:"is_highlighted"`
+	Cursor        string `query:"cursor"`
+	Limit         int    `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+type QuestionPageDTO struct {
+	Questions  []entities.Question `json:"questions"`
+	NextCursor string              `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,11 @@
+package dtos
+
+type ReplyDTO struct {
+	Content    string `json:"content" validate:"required"`
+	IsOfficial bool   `json:"is_official"`
+}
+
+type UpdateReplyDTO struct {
+	Content    *string `json:"content" validate:"required_without=IsOfficial,omitempty,min=1"`
+	IsOfficial *bool   `json:"is_official" validate:"required_without=Content"`
+}
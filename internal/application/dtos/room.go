@@ -0,0 +1,9 @@
+package dtos
+
+import "time"
+
+type RoomDTO struct {
+	Title            string     `json:"title" validate:"required"`
+	ScheduledStartAt *time.Time `json:"scheduled_start_at"`
+	ScheduledEndAt   *time.Time `json:"scheduled_end_at"`
+}
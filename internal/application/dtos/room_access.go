@@ -0,0 +1,15 @@
+package dtos
+
+import "time"
+
+type JoinRoomDTO struct {
+	Passcode string `json:"passcode"`
+}
+
+// RoomGrantDTO is what lets a user into a private room. It is sent back in
+// the X-Room-Grant header, or in the grant query parameter where headers can
+// not be set, such as when opening the feed of the room.
+type RoomGrantDTO struct {
+	Grant     string    `json:"grant"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
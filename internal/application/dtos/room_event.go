@@ -0,0 +1,34 @@
+package dtos
+
+import "time"
+
+const (
+	RoomSnapshotEvent        = "snapshot"
+	QuestionCreatedEvent     = "question_created"
+	QuestionLikedEvent       = "question_liked"
+	QuestionUnlikedEvent     = "question_unliked"
+	QuestionHighlightedEvent = "question_highlighted"
+	QuestionAnsweredEvent    = "question_answered"
+	QuestionDeletedEvent     = "question_deleted"
+	QuestionApprovedEvent    = "question_approved"
+	QuestionEditedEvent      = "question_edited"
+	ReplyCreatedEvent        = "reply_created"
+	ReplyUpdatedEvent        = "reply_updated"
+	ReplyDeletedEvent        = "reply_deleted"
+	PollOpenedEvent          = "poll_opened"
+	PollClosedEvent          = "poll_closed"
+	PollVotedEvent           = "poll_voted"
+	RoomEndedEvent           = "room_ended"
+	RoomReopenedEvent        = "room_reopened"
+	RoomSettingsUpdatedEvent = "room_settings_updated"
+	MemberAddedEvent         = "member_added"
+	MemberRemovedEvent       = "member_removed"
+)
+
+type RoomEventDTO struct {
+	ID        int64       `json:"id,omitempty"`
+	Type      string      `json:"type"`
+	RoomID    string      `json:"room_id"`
+	Data      interface{} `json:"data"`
+	CreatedAt time.Time   `json:"created_at"`
+}
@@ -0,0 +1,19 @@
+package dtos
+
+import "github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+
+type RoomQueryDTO struct {
+	AuthorID    string `query:"author_id"`
+	Status      string `query:"status" validate:"omitempty,oneof=open ended"`
+	Search      string `query:"search"`
+	CreatedFrom string `query:"created_from" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	CreatedTo   string `query:"created_to" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Sort        string `query:"sort" validate:"omitempty,oneof=created_at -created_at title -title"`
+	Cursor      string `query:"cursor"`
+	Limit       int    `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+type RoomPageDTO struct {
+	Rooms      []entities.Room `json:"rooms"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,13 @@
+package dtos
+
+type RoomSettingsDTO struct {
+	AllowAnonymousQuestions    *bool     `json:"allow_anonymous_questions" validate:"required_without_all=RequireApproval Visibility Passcode InvitedUserIDs InvitedDomains MaxQuestionsPerUser MinSecondsBetweenQuestions MaxOpenQuestionsPerUser"`
+	RequireApproval            *bool     `json:"require_approval" validate:"required_without_all=AllowAnonymousQuestions Visibility Passcode InvitedUserIDs InvitedDomains MaxQuestionsPerUser MinSecondsBetweenQuestions MaxOpenQuestionsPerUser"`
+	Visibility                 *string   `json:"visibility" validate:"omitempty,oneof=public passcode invite_only"`
+	Passcode                   *string   `json:"passcode" validate:"omitempty,min=4"`
+	InvitedUserIDs             *[]string `json:"invited_user_ids"`
+	InvitedDomains             *[]string `json:"invited_domains" validate:"omitempty,dive,fqdn"`
+	MaxQuestionsPerUser        *int      `json:"max_questions_per_user" validate:"omitempty,min=0"`
+	MinSecondsBetweenQuestions *int      `json:"min_seconds_between_questions" validate:"omitempty,min=0"`
+	MaxOpenQuestionsPerUser    *int      `json:"max_open_questions_per_user" validate:"omitempty,min=0"`
+}
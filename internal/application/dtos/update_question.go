@@ -3,4 +3,7 @@ package dtos
 type UpdateQuestionDTO struct {
 	IsAnswered    *bool `json:"is_answered" validate:"required_without=IsHighlighted"`
 	IsHighlighted *bool `json:"is_highlighted" validate:"required_without=IsAnswered"`
+	// AnswerID links the official reply that answered the question. It is only
+	// taken into account along with IsAnswered.
+	AnswerID *string `json:"answer_id"`
 }
@@ -0,0 +1,5 @@
+package dtos
+
+type VerifyEmailDTO struct {
+	Token string `query:"token" validate:"required"`
+}
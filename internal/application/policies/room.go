@@ -0,0 +1,195 @@
+package policies
+
+import (
+	"github.com/waliqueiroz/letmeask-api/internal/application/errors"
+	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+)
+
+const (
+	PermissionAskQuestion    = "ask_question"
+	PermissionLikeQuestion   = "like_question"
+	PermissionRemoveAnyLike  = "remove_any_like"
+	PermissionUpdateQuestion = "update_question"
+	PermissionDeleteQuestion = "delete_question"
+	PermissionEndRoom        = "end_room"
+	PermissionReopenRoom     = "reopen_room"
+	PermissionManageMembers  = "manage_members"
+	PermissionManageCoHosts  = "manage_co_hosts"
+	PermissionManageSettings = "manage_settings"
+	PermissionSeeAnonymous   = "see_anonymous"
+	PermissionReviewQuestion = "review_question"
+	PermissionReply          = "reply"
+	PermissionOfficialAnswer = "official_answer"
+	PermissionDeleteAnyReply = "delete_any_reply"
+	PermissionManagePolls    = "manage_polls"
+	PermissionVote           = "vote"
+	PermissionSeeRevisions   = "see_revisions"
+	PermissionWithdraw       = "withdraw_question"
+	PermissionAuditQuestions = "audit_questions"
+)
+
+// rolePermissions is the permission matrix of a room. Co-hosts run the room
+// just like the owner, except for promoting or removing other co-hosts and
+// reopening the room once it ended, and moderators only take care of the
+// questions. Everyone who moderates the room gets to see who is behind the
+// anonymous questions and how they were edited, but only those who run it
+// speak for the room through official answers, run its polls and audit the
+// deleted questions.
+var rolePermissions = map[string][]string{
+	entities.RoomRoleOwner: {
+		PermissionAskQuestion,
+		PermissionWithdraw,
+		PermissionLikeQuestion,
+		PermissionRemoveAnyLike,
+		PermissionUpdateQuestion,
+		PermissionDeleteQuestion,
+		PermissionEndRoom,
+		PermissionReopenRoom,
+		PermissionManageMembers,
+		PermissionManageCoHosts,
+		PermissionManageSettings,
+		PermissionSeeAnonymous,
+		PermissionReviewQuestion,
+		PermissionSeeRevisions,
+		PermissionReply,
+		PermissionOfficialAnswer,
+		PermissionDeleteAnyReply,
+		PermissionManagePolls,
+		PermissionAuditQuestions,
+		PermissionVote,
+	},
+	entities.RoomRoleCoHost: {
+		PermissionAskQuestion,
+		PermissionWithdraw,
+		PermissionLikeQuestion,
+		PermissionRemoveAnyLike,
+		PermissionUpdateQuestion,
+		PermissionDeleteQuestion,
+		PermissionEndRoom,
+		PermissionManageMembers,
+		PermissionManageSettings,
+		PermissionSeeAnonymous,
+		PermissionReviewQuestion,
+		PermissionSeeRevisions,
+		PermissionReply,
+		PermissionOfficialAnswer,
+		PermissionDeleteAnyReply,
+		PermissionManagePolls,
+		PermissionAuditQuestions,
+		PermissionVote,
+	},
+	entities.RoomRoleModerator: {
+		PermissionAskQuestion,
+		PermissionWithdraw,
+		PermissionLikeQuestion,
+		PermissionRemoveAnyLike,
+		PermissionUpdateQuestion,
+		PermissionDeleteQuestion,
+		PermissionSeeAnonymous,
+		PermissionReviewQuestion,
+		PermissionSeeRevisions,
+		PermissionReply,
+		PermissionDeleteAnyReply,
+		PermissionVote,
+	},
+	entities.RoomRoleAttendee: {
+		PermissionAskQuestion,
+		PermissionWithdraw,
+		PermissionLikeQuestion,
+		PermissionReply,
+		PermissionVote,
+	},
+}
+
+var permissionErrors = map[string]string{
+	PermissionAskQuestion:    "você não tem permissão para enviar perguntas nesta sala.",
+	PermissionLikeQuestion:   "você não tem permissão para curtir perguntas nesta sala.",
+	PermissionRemoveAnyLike:  "você não pode remover uma curtida que não é sua.",
+	PermissionUpdateQuestion: "você não tem permissão para atualizar as perguntas desta sala.",
+	PermissionDeleteQuestion: "você não tem permissão para remover as perguntas desta sala.",
+	PermissionEndRoom:        "você não tem permissão para encerrar esta sala.",
+	PermissionReopenRoom:     "somente o dono da sala pode reabri-la.",
+	PermissionManageMembers:  "você não tem permissão para gerenciar os membros desta sala.",
+	PermissionManageCoHosts:  "somente o dono da sala pode gerenciar os co-anfitriões.",
+	PermissionManageSettings: "você não tem permissão para alterar as configurações desta sala.",
+	PermissionSeeAnonymous:   "você não tem permissão para ver os autores de perguntas anônimas.",
+	PermissionReviewQuestion: "você não tem permissão para moderar as perguntas desta sala.",
+	PermissionReply:          "você não tem permissão para responder perguntas nesta sala.",
+	PermissionOfficialAnswer: "você não tem permissão para dar respostas oficiais nesta sala.",
+	PermissionDeleteAnyReply: "você não pode remover uma resposta que não é sua.",
+	PermissionManagePolls:    "você não tem permissão para gerenciar as enquetes desta sala.",
+	PermissionVote:           "você não tem permissão para votar nas enquetes desta sala.",
+	PermissionSeeRevisions:   "você não tem permissão para ver o histórico de edições das perguntas desta sala.",
+	PermissionWithdraw:       "você não tem permissão para retirar suas perguntas desta sala.",
+	PermissionAuditQuestions: "você não tem permissão para ver as perguntas removidas desta sala.",
+}
+
+type RoomPolicy interface {
+	CanCreate(actor entities.User) error
+	Authorize(room entities.Room, userID string, permission string) error
+	CanManageMember(room entities.Room, userID string, role string) error
+	CanDeleteQuestion(room entities.Room, userID string, question entities.Question) (string, error)
+}
+
+type roomPolicy struct {
+	requireVerifiedEmail bool
+}
+
+func NewRoomPolicy(requireVerifiedEmail bool) *roomPolicy {
+	return &roomPolicy{
+		requireVerifiedEmail,
+	}
+}
+
+// CanCreate keeps accounts that never confirmed their email from opening
+// rooms, unless the requirement is turned off.
+func (policy *roomPolicy) CanCreate(actor entities.User) error {
+	if policy.requireVerifiedEmail && !actor.IsVerified() {
+		return errors.NewForbiddenError("confirme seu e-mail antes de criar uma sala.")
+	}
+
+	return nil
+}
+
+// Authorize checks the permission against the role the user holds in the room.
+func (policy *roomPolicy) Authorize(room entities.Room, userID string, permission string) error {
+	for _, granted := range rolePermissions[room.RoleOf(userID)] {
+		if granted == permission {
+			return nil
+		}
+	}
+
+	return errors.NewForbiddenError(permissionErrors[permission])
+}
+
+// CanManageMember checks whether the user may grant or take away the given
+// role, which takes an extra permission when the role is co-host.
+func (policy *roomPolicy) CanManageMember(room entities.Room, userID string, role string) error {
+	if err := policy.Authorize(room, userID, PermissionManageMembers); err != nil {
+		return err
+	}
+
+	if role == entities.RoomRoleCoHost {
+		return policy.Authorize(room, userID, PermissionManageCoHosts)
+	}
+
+	return nil
+}
+
+// CanDeleteQuestion tells apart authors withdrawing their own questions from
+// the room staff removing someone else's, returning which one it is.
+func (policy *roomPolicy) CanDeleteQuestion(room entities.Room, userID string, question entities.Question) (string, error) {
+	if userID == question.Author.ID {
+		if err := policy.Authorize(room, userID, PermissionWithdraw); err != nil {
+			return "", err
+		}
+
+		return entities.QuestionWithdrawn, nil
+	}
+
+	if err := policy.Authorize(room, userID, PermissionDeleteQuestion); err != nil {
+		return "", err
+	}
+
+	return entities.QuestionRemoved, nil
+}
@@ -0,0 +1,306 @@
+package policies_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	application "github.com/waliqueiroz/letmeask-api/internal/application/errors"
+	"github.com/waliqueiroz/letmeask-api/internal/application/policies"
+	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+)
+
+var _ = Describe("Room", func() {
+
+	Describe("Executing the CanCreate function", func() {
+		var actor entities.User
+		var requireVerifiedEmail bool
+		var canCreateError error
+
+		JustBeforeEach(func() {
+			canCreateError = policies.NewRoomPolicy(requireVerifiedEmail).CanCreate(actor)
+		})
+
+		When("a verified user creates a room", func() {
+			BeforeEach(func() {
+				verifiedAt := time.Now()
+
+				requireVerifiedEmail = true
+				actor = entities.User{ID: "6117e377b6e7bae09f52c483", VerifiedAt: &verifiedAt}
+			})
+
+			It("error should be nil", func() {
+				Expect(canCreateError).Should(BeNil())
+			})
+		})
+
+		When("an unverified user creates a room", func() {
+			BeforeEach(func() {
+				requireVerifiedEmail = true
+				actor = entities.User{ID: "6117e377b6e7bae09f52c483"}
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(canCreateError).To(Equal(application.NewForbiddenError("confirme seu e-mail antes de criar uma sala.")))
+			})
+		})
+
+		When("verification is not required", func() {
+			BeforeEach(func() {
+				requireVerifiedEmail = false
+				actor = entities.User{ID: "6117e377b6e7bae09f52c483"}
+			})
+
+			It("error should be nil", func() {
+				Expect(canCreateError).Should(BeNil())
+			})
+		})
+	})
+
+	Describe("Executing the Authorize function", func() {
+		var userID string
+		var permission string
+		var authorizeError error
+
+		room := entities.Room{
+			ID:     "621f5ec1e07fdbb81c8221f7",
+			Author: entities.Author{ID: "6117e377b6e7bae09f52c483"},
+			Members: []entities.Member{
+				{User: entities.Author{ID: "6117e377b6e7bae09f52c483"}, Role: entities.RoomRoleOwner},
+				{User: entities.Author{ID: "621f5e02e07fdbb81c8221e5"}, Role: entities.RoomRoleCoHost},
+				{User: entities.Author{ID: "621f5e02e07fdbb81e8221f5"}, Role: entities.RoomRoleModerator},
+			},
+		}
+
+		JustBeforeEach(func() {
+			authorizeError = policies.NewRoomPolicy(true).Authorize(room, userID, permission)
+		})
+
+		When("the owner ends the room", func() {
+			BeforeEach(func() {
+				userID = "6117e377b6e7bae09f52c483"
+				permission = policies.PermissionEndRoom
+			})
+
+			It("error should be nil", func() {
+				Expect(authorizeError).Should(BeNil())
+			})
+		})
+
+		When("a co-host ends the room", func() {
+			BeforeEach(func() {
+				userID = "621f5e02e07fdbb81c8221e5"
+				permission = policies.PermissionEndRoom
+			})
+
+			It("error should be nil", func() {
+				Expect(authorizeError).Should(BeNil())
+			})
+		})
+
+		When("a moderator ends the room", func() {
+			BeforeEach(func() {
+				userID = "621f5e02e07fdbb81e8221f5"
+				permission = policies.PermissionEndRoom
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(authorizeError).To(Equal(application.NewForbiddenError("você não tem permissão para encerrar esta sala.")))
+			})
+		})
+
+		When("the owner reopens the room", func() {
+			BeforeEach(func() {
+				userID = "6117e377b6e7bae09f52c483"
+				permission = policies.PermissionReopenRoom
+			})
+
+			It("error should be nil", func() {
+				Expect(authorizeError).Should(BeNil())
+			})
+		})
+
+		When("a co-host reopens the room", func() {
+			BeforeEach(func() {
+				userID = "621f5e02e07fdbb81c8221e5"
+				permission = policies.PermissionReopenRoom
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(authorizeError).To(Equal(application.NewForbiddenError("somente o dono da sala pode reabri-la.")))
+			})
+		})
+
+		When("a moderator deletes a question", func() {
+			BeforeEach(func() {
+				userID = "621f5e02e07fdbb81e8221f5"
+				permission = policies.PermissionDeleteQuestion
+			})
+
+			It("error should be nil", func() {
+				Expect(authorizeError).Should(BeNil())
+			})
+		})
+
+		When("an attendee asks a question", func() {
+			BeforeEach(func() {
+				userID = "621f5e79e07fdbb81c8221f6"
+				permission = policies.PermissionAskQuestion
+			})
+
+			It("error should be nil", func() {
+				Expect(authorizeError).Should(BeNil())
+			})
+		})
+
+		When("an attendee updates a question", func() {
+			BeforeEach(func() {
+				userID = "621f5e79e07fdbb81c8221f6"
+				permission = policies.PermissionUpdateQuestion
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(authorizeError).To(Equal(application.NewForbiddenError("você não tem permissão para atualizar as perguntas desta sala.")))
+			})
+		})
+
+		When("a moderator gives an official answer", func() {
+			BeforeEach(func() {
+				userID = "621f5e02e07fdbb81e8221f5"
+				permission = policies.PermissionOfficialAnswer
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(authorizeError).To(Equal(application.NewForbiddenError("você não tem permissão para dar respostas oficiais nesta sala.")))
+			})
+		})
+	})
+
+	Describe("Executing the CanManageMember function", func() {
+		var userID string
+		var role string
+		var canManageMemberError error
+
+		room := entities.Room{
+			ID:     "621f5ec1e07fdbb81c8221f7",
+			Author: entities.Author{ID: "6117e377b6e7bae09f52c483"},
+			Members: []entities.Member{
+				{User: entities.Author{ID: "621f5e02e07fdbb81c8221e5"}, Role: entities.RoomRoleCoHost},
+				{User: entities.Author{ID: "621f5e02e07fdbb81e8221f5"}, Role: entities.RoomRoleModerator},
+			},
+		}
+
+		JustBeforeEach(func() {
+			canManageMemberError = policies.NewRoomPolicy(true).CanManageMember(room, userID, role)
+		})
+
+		When("the owner appoints a co-host", func() {
+			BeforeEach(func() {
+				userID = "6117e377b6e7bae09f52c483"
+				role = entities.RoomRoleCoHost
+			})
+
+			It("error should be nil", func() {
+				Expect(canManageMemberError).Should(BeNil())
+			})
+		})
+
+		When("a co-host appoints a moderator", func() {
+			BeforeEach(func() {
+				userID = "621f5e02e07fdbb81c8221e5"
+				role = entities.RoomRoleModerator
+			})
+
+			It("error should be nil", func() {
+				Expect(canManageMemberError).Should(BeNil())
+			})
+		})
+
+		When("a co-host appoints another co-host", func() {
+			BeforeEach(func() {
+				userID = "621f5e02e07fdbb81c8221e5"
+				role = entities.RoomRoleCoHost
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(canManageMemberError).To(Equal(application.NewForbiddenError("somente o dono da sala pode gerenciar os co-anfitriões.")))
+			})
+		})
+
+		When("a moderator appoints an attendee", func() {
+			BeforeEach(func() {
+				userID = "621f5e02e07fdbb81e8221f5"
+				role = entities.RoomRoleAttendee
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(canManageMemberError).To(Equal(application.NewForbiddenError("você não tem permissão para gerenciar os membros desta sala.")))
+			})
+		})
+	})
+
+	Describe("Executing the CanDeleteQuestion function", func() {
+		var userID string
+		var reason string
+		var canDeleteQuestionError error
+
+		room := entities.Room{
+			ID:     "621f5ec1e07fdbb81c8221f7",
+			Author: entities.Author{ID: "6117e377b6e7bae09f52c483"},
+			Members: []entities.Member{
+				{User: entities.Author{ID: "621f5e02e07fdbb81e8221f5"}, Role: entities.RoomRoleModerator},
+			},
+		}
+
+		question := entities.Question{
+			ID:     "621f5f94e07fdbb81c8221f9",
+			Author: entities.Author{ID: "621f5f40e07fdbb81c8221f8"},
+		}
+
+		JustBeforeEach(func() {
+			reason, canDeleteQuestionError = policies.NewRoomPolicy(true).CanDeleteQuestion(room, userID, question)
+		})
+
+		When("the author deletes the question", func() {
+			BeforeEach(func() {
+				userID = "621f5f40e07fdbb81c8221f8"
+			})
+
+			It("reason should be a withdrawal", func() {
+				Expect(reason).To(Equal(entities.QuestionWithdrawn))
+			})
+
+			It("error should be nil", func() {
+				Expect(canDeleteQuestionError).Should(BeNil())
+			})
+		})
+
+		When("a moderator deletes the question", func() {
+			BeforeEach(func() {
+				userID = "621f5e02e07fdbb81e8221f5"
+			})
+
+			It("reason should be a removal", func() {
+				Expect(reason).To(Equal(entities.QuestionRemoved))
+			})
+
+			It("error should be nil", func() {
+				Expect(canDeleteQuestionError).Should(BeNil())
+			})
+		})
+
+		When("another attendee deletes the question", func() {
+			BeforeEach(func() {
+				userID = "621f5e79e07fdbb81c8221f6"
+			})
+
+			It("reason should be empty", func() {
+				Expect(reason).To(BeEmpty())
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(canDeleteQuestionError).To(Equal(application.NewForbiddenError("você não tem permissão para remover as perguntas desta sala.")))
+			})
+		})
+	})
+})
@@ -0,0 +1,25 @@
+package policies
+
+import (
+	"github.com/waliqueiroz/letmeask-api/internal/application/errors"
+	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+)
+
+type UserPolicy interface {
+	CanManage(actor entities.User, userID string) error
+}
+
+type userPolicy struct{}
+
+func NewUserPolicy() *userPolicy {
+	return &userPolicy{}
+}
+
+// CanManage allows an account to be changed only by its owner or by an admin.
+func (policy *userPolicy) CanManage(actor entities.User, userID string) error {
+	if actor.ID == userID || actor.IsAdmin() {
+		return nil
+	}
+
+	return errors.NewForbiddenError("você não tem permissão para alterar este usuário.")
+}
@@ -0,0 +1,56 @@
+package policies_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	application "github.com/waliqueiroz/letmeask-api/internal/application/errors"
+	"github.com/waliqueiroz/letmeask-api/internal/application/policies"
+	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+)
+
+var _ = Describe("User", func() {
+
+	Describe("Executing the CanManage function", func() {
+		var actor entities.User
+		var userID string
+		var canManageError error
+
+		JustBeforeEach(func() {
+			canManageError = policies.NewUserPolicy().CanManage(actor, userID)
+		})
+
+		When("the user manages its own account", func() {
+			BeforeEach(func() {
+				actor = entities.User{ID: "6117e377b6e7bae09f52c483", Role: entities.UserRoleUser}
+				userID = "6117e377b6e7bae09f52c483"
+			})
+
+			It("error should be nil", func() {
+				Expect(canManageError).Should(BeNil())
+			})
+		})
+
+		When("an admin manages another account", func() {
+			BeforeEach(func() {
+				actor = entities.User{ID: "621f5e02e07fdbb81c8221f5", Role: entities.UserRoleAdmin}
+				userID = "6117e377b6e7bae09f52c483"
+			})
+
+			It("error should be nil", func() {
+				Expect(canManageError).Should(BeNil())
+			})
+		})
+
+		When("a user tries to manage another account", func() {
+			BeforeEach(func() {
+				actor = entities.User{ID: "621f5f40e07fdbb81c8221f8", Role: entities.UserRoleUser}
+				userID = "6117e377b6e7bae09f52c483"
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(canManageError).To(Equal(application.NewForbiddenError("você não tem permissão para alterar este usuário.")))
+			})
+		})
+	})
+
+})
@@ -1,6 +1,16 @@
 package providers
 
+import "time"
+
+type TokenClaims struct {
+	ID         string
+	UserID     string
+	Generation int
+	ExpiresAt  time.Time
+}
+
 type Authenticator interface {
-	CreateToken(userID string, expiresIn int64) (string, error)
+	CreateToken(userID string, generation int, expiresIn int64) (string, error)
 	ExtractUserID(token interface{}) (string, error)
+	ExtractClaims(token interface{}) (TokenClaims, error)
 }
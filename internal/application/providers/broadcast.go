@@ -0,0 +1,9 @@
+package providers
+
+import "github.com/waliqueiroz/letmeask-api/internal/application/dtos"
+
+type Broadcaster interface {
+	Publish(event dtos.RoomEventDTO)
+	Subscribe(roomID string) (<-chan dtos.RoomEventDTO, func())
+	History(roomID string, lastEventID int64) ([]dtos.RoomEventDTO, bool)
+}
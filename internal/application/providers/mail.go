@@ -0,0 +1,7 @@
+package providers
+
+import "github.com/waliqueiroz/letmeask-api/internal/application/dtos"
+
+type Mailer interface {
+	Send(mail dtos.MailDTO) error
+}
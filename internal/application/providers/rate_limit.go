@@ -0,0 +1,16 @@
+package providers
+
+import "time"
+
+// RateLimit lets a burst of Requests through and then refills them evenly
+// over Period.
+type RateLimit struct {
+	Requests int
+	Period   time.Duration
+}
+
+type RateLimiter interface {
+	// Allow takes one request from the bucket of the key. When the bucket is
+	// empty it returns false along with how long until a request is available.
+	Allow(key string, limit RateLimit) (bool, time.Duration, error)
+}
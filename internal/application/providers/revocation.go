@@ -0,0 +1,8 @@
+package providers
+
+import "time"
+
+type RevocationStore interface {
+	Revoke(tokenID string, expiresAt time.Time) error
+	IsRevoked(tokenID string) (bool, error)
+}
@@ -0,0 +1,8 @@
+package providers
+
+import "time"
+
+type Signer interface {
+	Sign(value string, expiresAt time.Time) string
+	Verify(token string) (string, error)
+}
@@ -0,0 +1,6 @@
+package providers
+
+type TokenGenerator interface {
+	Generate() (string, error)
+	Hash(token string) string
+}
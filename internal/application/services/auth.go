@@ -1,53 +1,252 @@
 package services
 
 import (
+	"errors"
 	"time"
 
 	"github.com/waliqueiroz/letmeask-api/internal/application/dtos"
-	"github.com/waliqueiroz/letmeask-api/internal/application/errors"
+	application "github.com/waliqueiroz/letmeask-api/internal/application/errors"
 	"github.com/waliqueiroz/letmeask-api/internal/application/providers"
+	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+	domain "github.com/waliqueiroz/letmeask-api/internal/domain/errors"
 	"github.com/waliqueiroz/letmeask-api/internal/domain/repositories"
 )
 
 type AuthService interface {
 	Login(credentials dtos.CredentialsDTO) (dtos.AuthDTO, error)
+	Refresh(refreshToken string) (dtos.AuthDTO, error)
+	Logout(claims providers.TokenClaims, refreshToken string) error
+	ValidateToken(claims providers.TokenClaims) error
+}
+
+type AuthConfig struct {
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	// LockoutThreshold is how many failed logins within FailureWindow lock
+	// the account. From then on each failure locks it again for twice as long
+	// as the previous one, starting at LockoutDuration and up to
+	// MaxLockoutDuration, until the window runs out and counting starts over.
+	LockoutThreshold   int
+	LockoutDuration    time.Duration
+	MaxLockoutDuration time.Duration
+	FailureWindow      time.Duration
 }
 
 type authService struct {
-	userRepository   repositories.UserRepository
-	securityProvider providers.SecurityProvider
-	authenticator    providers.Authenticator
+	userRepository    repositories.UserRepository
+	sessionRepository repositories.SessionRepository
+	securityProvider  providers.SecurityProvider
+	authenticator     providers.Authenticator
+	tokenGenerator    providers.TokenGenerator
+	revocationStore   providers.RevocationStore
+	config            AuthConfig
 }
 
-func NewAuthService(userRepository repositories.UserRepository, securityProvider providers.SecurityProvider, authProvider providers.Authenticator) *authService {
+func NewAuthService(userRepository repositories.UserRepository, sessionRepository repositories.SessionRepository, securityProvider providers.SecurityProvider, authProvider providers.Authenticator, tokenGenerator providers.TokenGenerator, revocationStore providers.RevocationStore, config AuthConfig) *authService {
 	return &authService{
 		userRepository,
+		sessionRepository,
 		securityProvider,
 		authProvider,
+		tokenGenerator,
+		revocationStore,
+		config,
 	}
 }
 
 func (service *authService) Login(credentials dtos.CredentialsDTO) (dtos.AuthDTO, error) {
 	user, err := service.userRepository.FindByEmail(credentials.Email)
 	if err != nil {
-		return dtos.AuthDTO{}, errors.NewUnauthorizedError("credenciais inválidas")
+		return dtos.AuthDTO{}, application.NewUnauthorizedError("credenciais inválidas")
+	}
+
+	// A locked account is refused before the password is checked, so that
+	// guessing costs neither a hash verification nor a chance to succeed. It
+	// gets the same answer as an unknown email, or the lockout would tell
+	// which emails have an account.
+	now := time.Now()
+	if user.IsLocked(now) {
+		return dtos.AuthDTO{}, application.NewUnauthorizedError("credenciais inválidas")
 	}
 
 	if err := service.securityProvider.Verify(user.Password, credentials.Password); err != nil {
-		return dtos.AuthDTO{}, errors.NewUnauthorizedError("credenciais inválidas")
+		if err := service.recordFailedLogin(user.ID, now); err != nil {
+			return dtos.AuthDTO{}, err
+		}
+
+		return dtos.AuthDTO{}, application.NewUnauthorizedError("credenciais inválidas")
+	}
+
+	if user.FailedLogins > 0 {
+		if err := service.userRepository.ResetFailedLogins(user.ID); err != nil {
+			return dtos.AuthDTO{}, err
+		}
+	}
+
+	return service.issueTokens(user, "")
+}
+
+func (service *authService) Refresh(refreshToken string) (dtos.AuthDTO, error) {
+	session, err := service.findSession(refreshToken)
+	if err != nil {
+		return dtos.AuthDTO{}, err
+	}
+
+	// A rotated token showing up again means it was copied: the legitimate
+	// client already traded it, so nobody holding this family can be trusted.
+	if session.RotatedAt != nil {
+		if err := service.sessionRepository.RevokeFamily(session.FamilyID); err != nil {
+			return dtos.AuthDTO{}, err
+		}
+
+		return dtos.AuthDTO{}, application.NewUnauthorizedError("refresh token inválido")
+	}
+
+	if !session.IsActive() {
+		return dtos.AuthDTO{}, application.NewUnauthorizedError("refresh token inválido")
+	}
+
+	err = service.sessionRepository.MarkAsRotated(session.ID)
+	if err != nil {
+		var conflictError *domain.ConflictError
+		if !errors.As(err, &conflictError) {
+			return dtos.AuthDTO{}, err
+		}
+
+		if err := service.sessionRepository.RevokeFamily(session.FamilyID); err != nil {
+			return dtos.AuthDTO{}, err
+		}
+
+		return dtos.AuthDTO{}, application.NewUnauthorizedError("refresh token inválido")
+	}
+
+	user, err := service.userRepository.FindByID(session.UserID)
+	if err != nil {
+		return dtos.AuthDTO{}, err
+	}
+
+	// The password changed after this session was opened, so it must not be
+	// able to mint access tokens for the new credentials.
+	if session.TokenGeneration != user.TokenGeneration {
+		if err := service.sessionRepository.RevokeFamily(session.FamilyID); err != nil {
+			return dtos.AuthDTO{}, err
+		}
+
+		return dtos.AuthDTO{}, application.NewUnauthorizedError("refresh token inválido")
 	}
 
-	expiresIn := time.Now().Add(time.Hour * 6).Unix()
+	return service.issueTokens(user, session.FamilyID)
+}
+
+func (service *authService) Logout(claims providers.TokenClaims, refreshToken string) error {
+	session, err := service.findSession(refreshToken)
+	if err != nil {
+		return err
+	}
+
+	if session.UserID != claims.UserID {
+		return application.NewUnauthorizedError("refresh token inválido")
+	}
+
+	if err := service.revocationStore.Revoke(claims.ID, claims.ExpiresAt); err != nil {
+		return err
+	}
+
+	return service.sessionRepository.RevokeFamily(session.FamilyID)
+}
+
+func (service *authService) ValidateToken(claims providers.TokenClaims) error {
+	revoked, err := service.revocationStore.IsRevoked(claims.ID)
+	if err != nil {
+		return err
+	}
+
+	if revoked {
+		return application.NewUnauthorizedError("token revogado")
+	}
+
+	user, err := service.userRepository.FindByID(claims.UserID)
+	if err != nil {
+		var notFoundError *domain.ResourceNotFoundError
+		if errors.As(err, &notFoundError) {
+			return application.NewUnauthorizedError("token inválido")
+		}
+
+		return err
+	}
+
+	if claims.Generation < user.TokenGeneration {
+		return application.NewUnauthorizedError("token revogado")
+	}
+
+	return nil
+}
+
+func (service *authService) recordFailedLogin(userID string, now time.Time) error {
+	failedLogins, err := service.userRepository.IncrementFailedLogins(userID, now.Add(-service.config.FailureWindow), now)
+	if err != nil {
+		return err
+	}
+
+	if service.config.LockoutThreshold <= 0 || failedLogins < service.config.LockoutThreshold {
+		return nil
+	}
+
+	lockout := service.config.LockoutDuration
+	for failures := service.config.LockoutThreshold; failures < failedLogins && lockout < service.config.MaxLockoutDuration; failures++ {
+		lockout *= 2
+	}
+
+	if lockout > service.config.MaxLockoutDuration {
+		lockout = service.config.MaxLockoutDuration
+	}
+
+	return service.userRepository.LockUntil(userID, now.Add(lockout))
+}
+
+func (service *authService) findSession(refreshToken string) (entities.Session, error) {
+	session, err := service.sessionRepository.FindByTokenHash(service.tokenGenerator.Hash(refreshToken))
+	if err != nil {
+		var notFoundError *domain.ResourceNotFoundError
+		if errors.As(err, &notFoundError) {
+			return entities.Session{}, application.NewUnauthorizedError("refresh token inválido")
+		}
+
+		return entities.Session{}, err
+	}
+
+	return session, nil
+}
+
+func (service *authService) issueTokens(user entities.User, familyID string) (dtos.AuthDTO, error) {
+	expiresIn := time.Now().Add(service.config.AccessTokenTTL).Unix()
+
+	token, err := service.authenticator.CreateToken(user.ID, user.TokenGeneration, expiresIn)
+	if err != nil {
+		return dtos.AuthDTO{}, err
+	}
+
+	refreshToken, err := service.tokenGenerator.Generate()
+	if err != nil {
+		return dtos.AuthDTO{}, err
+	}
 
-	token, err := service.authenticator.CreateToken(user.ID, expiresIn)
+	_, err = service.sessionRepository.Create(entities.Session{
+		UserID:          user.ID,
+		FamilyID:        familyID,
+		TokenHash:       service.tokenGenerator.Hash(refreshToken),
+		TokenGeneration: user.TokenGeneration,
+		ExpiresAt:       time.Now().Add(service.config.RefreshTokenTTL),
+	})
 	if err != nil {
 		return dtos.AuthDTO{}, err
 	}
 
 	return dtos.AuthDTO{
-		User:        user,
-		AccessToken: token,
-		TokenType:   "Bearer",
-		ExpiresIn:   expiresIn,
+		User:         user,
+		AccessToken:  token,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    expiresIn,
 	}, nil
 }
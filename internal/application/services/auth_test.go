@@ -4,20 +4,32 @@ import (
 	"encoding/json"
 	"errors"
 	"io/ioutil"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/waliqueiroz/letmeask-api/internal/application/dtos"
 	application "github.com/waliqueiroz/letmeask-api/internal/application/errors"
+	"github.com/waliqueiroz/letmeask-api/internal/application/providers"
 	"github.com/waliqueiroz/letmeask-api/internal/application/services"
 	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+	domain "github.com/waliqueiroz/letmeask-api/internal/domain/errors"
 	authMocks "github.com/waliqueiroz/letmeask-api/internal/infrastructure/authentication/mocks"
 	repositoriesMocks "github.com/waliqueiroz/letmeask-api/internal/infrastructure/database/mongodb/repositories/mocks"
+	revocationMocks "github.com/waliqueiroz/letmeask-api/internal/infrastructure/revocation/mocks"
 	securityMocks "github.com/waliqueiroz/letmeask-api/internal/infrastructure/security/mocks"
 )
 
 var _ = Describe("Auth", func() {
+	authConfig := services.AuthConfig{
+		AccessTokenTTL:     15 * time.Minute,
+		RefreshTokenTTL:    720 * time.Hour,
+		LockoutThreshold:   5,
+		LockoutDuration:    time.Minute,
+		MaxLockoutDuration: time.Hour,
+		FailureWindow:      time.Hour,
+	}
 
 	Describe("Executing the Login funcition", func() {
 		var result dtos.AuthDTO
@@ -58,9 +70,22 @@ var _ = Describe("Auth", func() {
 				mockSecurityProvider.EXPECT().Verify(expectedFindByEmailResult.Password, credentials.Password).Return(nil).Times(1)
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
-				mockAuthenticator.EXPECT().CreateToken(expectedFindByEmailResult.ID, gomock.Any()).Return(expectedCreateTokenResult, nil).Times(1)
+				mockAuthenticator.EXPECT().CreateToken(expectedFindByEmailResult.ID, expectedFindByEmailResult.TokenGeneration, gomock.Any()).Return(expectedCreateTokenResult, nil).Times(1)
 
-				authService = services.NewAuthService(mockUserRepository, mockSecurityProvider, mockAuthenticator)
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+				mockTokenGenerator.EXPECT().Generate().Return("refresh-token", nil).Times(1)
+				mockTokenGenerator.EXPECT().Hash("refresh-token").Return("hashed-refresh-token").Times(1)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+				mockSessionRepository.EXPECT().Create(gomock.AssignableToTypeOf(entities.Session{})).Do(func(session entities.Session) {
+					Expect(session.UserID).To(Equal(expectedFindByEmailResult.ID))
+					Expect(session.FamilyID).To(BeEmpty())
+					Expect(session.TokenHash).To(Equal("hashed-refresh-token"))
+				}).Return(entities.Session{}, nil).Times(1)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
 			})
 
 			It("result user should be equal to expected user", func() {
@@ -71,6 +96,10 @@ var _ = Describe("Auth", func() {
 				Expect(result.AccessToken).To(Equal(expectedCreateTokenResult))
 			})
 
+			It("result refresh token should be equal to the generated token", func() {
+				Expect(result.RefreshToken).To(Equal("refresh-token"))
+			})
+
 			It("result token type should be equal to Bearer", func() {
 				Expect(result.TokenType).To(Equal("Bearer"))
 			})
@@ -105,7 +134,13 @@ var _ = Describe("Auth", func() {
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
 
-				authService = services.NewAuthService(mockUserRepository, mockSecurityProvider, mockAuthenticator)
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
 			})
 
 			It("result should be an empty struct", func() {
@@ -144,9 +179,19 @@ var _ = Describe("Auth", func() {
 				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
 				mockSecurityProvider.EXPECT().Verify(expectedUser.Password, credentials.Password).Return(errors.New("an error")).Times(1)
 
+				mockUserRepository.EXPECT().IncrementFailedLogins(expectedUser.ID, gomock.Any(), gomock.Any()).Do(func(userID string, windowStart time.Time, now time.Time) {
+					Expect(now.Sub(windowStart)).To(Equal(authConfig.FailureWindow))
+				}).Return(1, nil).Times(1)
+
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
 
-				authService = services.NewAuthService(mockUserRepository, mockSecurityProvider, mockAuthenticator)
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
 			})
 
 			It("result should be an empty struct", func() {
@@ -186,9 +231,15 @@ var _ = Describe("Auth", func() {
 				mockSecurityProvider.EXPECT().Verify(expectedUser.Password, credentials.Password).Return(nil).Times(1)
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
-				mockAuthenticator.EXPECT().CreateToken(expectedUser.ID, gomock.Any()).Return("", errors.New("an error")).Times(1)
+				mockAuthenticator.EXPECT().CreateToken(expectedUser.ID, expectedUser.TokenGeneration, gomock.Any()).Return("", errors.New("an error")).Times(1)
 
-				authService = services.NewAuthService(mockUserRepository, mockSecurityProvider, mockAuthenticator)
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
 			})
 
 			It("result should be an empty struct", func() {
@@ -203,6 +254,973 @@ var _ = Describe("Auth", func() {
 				mockCtrl.Finish()
 			})
 		})
+
+		When("the failed logins reach the lockout threshold", func() {
+			BeforeEach(func() {
+				credentialsSerialized, err := ioutil.ReadFile("../../../test/resources/credentials.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedUserSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(credentialsSerialized, &credentials)
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedUser entities.User
+				err = json.Unmarshal(expectedUserSerialized, &expectedUser)
+				Expect(err).NotTo(HaveOccurred())
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByEmail(credentials.Email).Return(expectedUser, nil).Times(1)
+				mockUserRepository.EXPECT().IncrementFailedLogins(expectedUser.ID, gomock.Any(), gomock.Any()).Return(5, nil).Times(1)
+				mockUserRepository.EXPECT().LockUntil(expectedUser.ID, gomock.AssignableToTypeOf(time.Time{})).Do(func(userID string, lockedUntil time.Time) {
+					Expect(lockedUntil).To(BeTemporally("~", time.Now().Add(time.Minute), time.Second))
+				}).Return(nil).Times(1)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+				mockSecurityProvider.EXPECT().Verify(expectedUser.Password, credentials.Password).Return(errors.New("an error")).Times(1)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
+			})
+
+			It("result should be an empty struct", func() {
+				Expect(result).To(Equal(dtos.AuthDTO{}))
+			})
+
+			It("error should an unauthorized error", func() {
+				Expect(authError).To(Equal(application.NewUnauthorizedError("credenciais inválidas")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the failed logins go on past the lockout threshold", func() {
+			BeforeEach(func() {
+				credentialsSerialized, err := ioutil.ReadFile("../../../test/resources/credentials.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedUserSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(credentialsSerialized, &credentials)
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedUser entities.User
+				err = json.Unmarshal(expectedUserSerialized, &expectedUser)
+				Expect(err).NotTo(HaveOccurred())
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByEmail(credentials.Email).Return(expectedUser, nil).Times(1)
+				mockUserRepository.EXPECT().IncrementFailedLogins(expectedUser.ID, gomock.Any(), gomock.Any()).Return(7, nil).Times(1)
+				mockUserRepository.EXPECT().LockUntil(expectedUser.ID, gomock.AssignableToTypeOf(time.Time{})).Do(func(userID string, lockedUntil time.Time) {
+					Expect(lockedUntil).To(BeTemporally("~", time.Now().Add(4*time.Minute), time.Second))
+				}).Return(nil).Times(1)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+				mockSecurityProvider.EXPECT().Verify(expectedUser.Password, credentials.Password).Return(errors.New("an error")).Times(1)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
+			})
+
+			It("result should be an empty struct", func() {
+				Expect(result).To(Equal(dtos.AuthDTO{}))
+			})
+
+			It("error should an unauthorized error", func() {
+				Expect(authError).To(Equal(application.NewUnauthorizedError("credenciais inválidas")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the failed logins would lock the account for longer than allowed", func() {
+			BeforeEach(func() {
+				credentialsSerialized, err := ioutil.ReadFile("../../../test/resources/credentials.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedUserSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(credentialsSerialized, &credentials)
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedUser entities.User
+				err = json.Unmarshal(expectedUserSerialized, &expectedUser)
+				Expect(err).NotTo(HaveOccurred())
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByEmail(credentials.Email).Return(expectedUser, nil).Times(1)
+				mockUserRepository.EXPECT().IncrementFailedLogins(expectedUser.ID, gomock.Any(), gomock.Any()).Return(20, nil).Times(1)
+				mockUserRepository.EXPECT().LockUntil(expectedUser.ID, gomock.AssignableToTypeOf(time.Time{})).Do(func(userID string, lockedUntil time.Time) {
+					Expect(lockedUntil).To(BeTemporally("~", time.Now().Add(time.Hour), time.Second))
+				}).Return(nil).Times(1)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+				mockSecurityProvider.EXPECT().Verify(expectedUser.Password, credentials.Password).Return(errors.New("an error")).Times(1)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
+			})
+
+			It("result should be an empty struct", func() {
+				Expect(result).To(Equal(dtos.AuthDTO{}))
+			})
+
+			It("error should an unauthorized error", func() {
+				Expect(authError).To(Equal(application.NewUnauthorizedError("credenciais inválidas")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the account is locked", func() {
+			BeforeEach(func() {
+				credentialsSerialized, err := ioutil.ReadFile("../../../test/resources/credentials.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedUserSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(credentialsSerialized, &credentials)
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedUser entities.User
+				err = json.Unmarshal(expectedUserSerialized, &expectedUser)
+				Expect(err).NotTo(HaveOccurred())
+
+				lockedUntil := time.Now().Add(10 * time.Minute)
+				expectedUser.FailedLogins = 5
+				expectedUser.LockedUntil = &lockedUntil
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByEmail(credentials.Email).Return(expectedUser, nil).Times(1)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
+			})
+
+			It("result should be an empty struct", func() {
+				Expect(result).To(Equal(dtos.AuthDTO{}))
+			})
+
+			It("error should be the same unauthorized error as for an unknown email", func() {
+				Expect(authError).To(Equal(application.NewUnauthorizedError("credenciais inválidas")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user logs in after failed attempts", func() {
+			var expectedFindByEmailResult entities.User
+
+			BeforeEach(func() {
+				credentialsSerialized, err := ioutil.ReadFile("../../../test/resources/credentials.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedUserSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(credentialsSerialized, &credentials)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(expectedUserSerialized, &expectedFindByEmailResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				lockedUntil := time.Now().Add(-time.Minute)
+				expectedFindByEmailResult.FailedLogins = 5
+				expectedFindByEmailResult.LockedUntil = &lockedUntil
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByEmail(credentials.Email).Return(expectedFindByEmailResult, nil).Times(1)
+				mockUserRepository.EXPECT().ResetFailedLogins(expectedFindByEmailResult.ID).Return(nil).Times(1)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+				mockSecurityProvider.EXPECT().Verify(expectedFindByEmailResult.Password, credentials.Password).Return(nil).Times(1)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().CreateToken(expectedFindByEmailResult.ID, expectedFindByEmailResult.TokenGeneration, gomock.Any()).Return("token", nil).Times(1)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+				mockTokenGenerator.EXPECT().Generate().Return("refresh-token", nil).Times(1)
+				mockTokenGenerator.EXPECT().Hash("refresh-token").Return("hashed-refresh-token").Times(1)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+				mockSessionRepository.EXPECT().Create(gomock.AssignableToTypeOf(entities.Session{})).Return(entities.Session{}, nil).Times(1)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
+			})
+
+			It("result access token should be the created token", func() {
+				Expect(result.AccessToken).To(Equal("token"))
+			})
+
+			It("error should be nil", func() {
+				Expect(authError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the Refresh function", func() {
+		var refreshToken string
+		var result dtos.AuthDTO
+		var refreshError error
+		var authService services.AuthService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, refreshError = authService.Refresh(refreshToken)
+		})
+
+		When("the refresh token is rotated with success", func() {
+			var expectedUser entities.User
+
+			BeforeEach(func() {
+				expectedUserSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(expectedUserSerialized, &expectedUser)
+				Expect(err).NotTo(HaveOccurred())
+
+				refreshToken = "refresh-token"
+
+				session := entities.Session{
+					ID:        "61f3e0b4c0b9a1a6d4a1e001",
+					UserID:    expectedUser.ID,
+					FamilyID:  "61f3e0b4c0b9a1a6d4a1e000",
+					TokenHash: "hashed-refresh-token",
+					ExpiresAt: time.Now().Add(time.Hour),
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(expectedUser.ID).Return(expectedUser, nil).Times(1)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().CreateToken(expectedUser.ID, expectedUser.TokenGeneration, gomock.Any()).Return("access-token", nil).Times(1)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+				mockTokenGenerator.EXPECT().Hash(refreshToken).Return("hashed-refresh-token").Times(1)
+				mockTokenGenerator.EXPECT().Generate().Return("new-refresh-token", nil).Times(1)
+				mockTokenGenerator.EXPECT().Hash("new-refresh-token").Return("hashed-new-refresh-token").Times(1)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+				mockSessionRepository.EXPECT().FindByTokenHash("hashed-refresh-token").Return(session, nil).Times(1)
+				mockSessionRepository.EXPECT().MarkAsRotated(session.ID).Return(nil).Times(1)
+				mockSessionRepository.EXPECT().Create(gomock.AssignableToTypeOf(entities.Session{})).Do(func(newSession entities.Session) {
+					Expect(newSession.UserID).To(Equal(expectedUser.ID))
+					Expect(newSession.FamilyID).To(Equal(session.FamilyID))
+					Expect(newSession.TokenHash).To(Equal("hashed-new-refresh-token"))
+				}).Return(entities.Session{}, nil).Times(1)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
+			})
+
+			It("result should carry the new tokens", func() {
+				Expect(result.User).To(Equal(expectedUser))
+				Expect(result.AccessToken).To(Equal("access-token"))
+				Expect(result.RefreshToken).To(Equal("new-refresh-token"))
+				Expect(result.TokenType).To(Equal("Bearer"))
+			})
+
+			It("error should be nil", func() {
+				Expect(refreshError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an already rotated refresh token is reused", func() {
+			BeforeEach(func() {
+				refreshToken = "refresh-token"
+				rotatedAt := time.Now().Add(-time.Minute)
+
+				session := entities.Session{
+					ID:        "61f3e0b4c0b9a1a6d4a1e001",
+					UserID:    "6117e377b6e7bae09f52c483",
+					FamilyID:  "61f3e0b4c0b9a1a6d4a1e000",
+					TokenHash: "hashed-refresh-token",
+					ExpiresAt: time.Now().Add(time.Hour),
+					RotatedAt: &rotatedAt,
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+				mockTokenGenerator.EXPECT().Hash(refreshToken).Return("hashed-refresh-token").Times(1)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+				mockSessionRepository.EXPECT().FindByTokenHash("hashed-refresh-token").Return(session, nil).Times(1)
+				mockSessionRepository.EXPECT().RevokeFamily(session.FamilyID).Return(nil).Times(1)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
+			})
+
+			It("result should be an empty struct", func() {
+				Expect(result).To(Equal(dtos.AuthDTO{}))
+			})
+
+			It("error should be an unauthorized error", func() {
+				Expect(refreshError).To(Equal(application.NewUnauthorizedError("refresh token inválido")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the refresh token is rotated concurrently by another request", func() {
+			BeforeEach(func() {
+				refreshToken = "refresh-token"
+
+				session := entities.Session{
+					ID:        "61f3e0b4c0b9a1a6d4a1e001",
+					UserID:    "6117e377b6e7bae09f52c483",
+					FamilyID:  "61f3e0b4c0b9a1a6d4a1e000",
+					TokenHash: "hashed-refresh-token",
+					ExpiresAt: time.Now().Add(time.Hour),
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+				mockTokenGenerator.EXPECT().Hash(refreshToken).Return("hashed-refresh-token").Times(1)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+				mockSessionRepository.EXPECT().FindByTokenHash("hashed-refresh-token").Return(session, nil).Times(1)
+				mockSessionRepository.EXPECT().MarkAsRotated(session.ID).Return(domain.NewConflictError("a sessão já foi renovada")).Times(1)
+				mockSessionRepository.EXPECT().RevokeFamily(session.FamilyID).Return(nil).Times(1)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
+			})
+
+			It("result should be an empty struct", func() {
+				Expect(result).To(Equal(dtos.AuthDTO{}))
+			})
+
+			It("error should be an unauthorized error", func() {
+				Expect(refreshError).To(Equal(application.NewUnauthorizedError("refresh token inválido")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the refresh token is expired", func() {
+			BeforeEach(func() {
+				refreshToken = "refresh-token"
+
+				session := entities.Session{
+					ID:        "61f3e0b4c0b9a1a6d4a1e001",
+					UserID:    "6117e377b6e7bae09f52c483",
+					FamilyID:  "61f3e0b4c0b9a1a6d4a1e000",
+					TokenHash: "hashed-refresh-token",
+					ExpiresAt: time.Now().Add(-time.Minute),
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+				mockTokenGenerator.EXPECT().Hash(refreshToken).Return("hashed-refresh-token").Times(1)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+				mockSessionRepository.EXPECT().FindByTokenHash("hashed-refresh-token").Return(session, nil).Times(1)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
+			})
+
+			It("result should be an empty struct", func() {
+				Expect(result).To(Equal(dtos.AuthDTO{}))
+			})
+
+			It("error should be an unauthorized error", func() {
+				Expect(refreshError).To(Equal(application.NewUnauthorizedError("refresh token inválido")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the password changed after the session was opened", func() {
+			BeforeEach(func() {
+				refreshToken = "refresh-token"
+
+				user := entities.User{
+					ID:              "6117e377b6e7bae09f52c483",
+					TokenGeneration: 1,
+				}
+
+				session := entities.Session{
+					ID:        "61f3e0b4c0b9a1a6d4a1e001",
+					UserID:    user.ID,
+					FamilyID:  "61f3e0b4c0b9a1a6d4a1e000",
+					TokenHash: "hashed-refresh-token",
+					ExpiresAt: time.Now().Add(time.Hour),
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(user.ID).Return(user, nil).Times(1)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+				mockTokenGenerator.EXPECT().Hash(refreshToken).Return("hashed-refresh-token").Times(1)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+				mockSessionRepository.EXPECT().FindByTokenHash("hashed-refresh-token").Return(session, nil).Times(1)
+				mockSessionRepository.EXPECT().MarkAsRotated(session.ID).Return(nil).Times(1)
+				mockSessionRepository.EXPECT().RevokeFamily(session.FamilyID).Return(nil).Times(1)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
+			})
+
+			It("result should be an empty struct", func() {
+				Expect(result).To(Equal(dtos.AuthDTO{}))
+			})
+
+			It("error should be an unauthorized error", func() {
+				Expect(refreshError).To(Equal(application.NewUnauthorizedError("refresh token inválido")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the refresh token does not exist", func() {
+			BeforeEach(func() {
+				refreshToken = "unknown-refresh-token"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+				mockTokenGenerator.EXPECT().Hash(refreshToken).Return("hashed-unknown-refresh-token").Times(1)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+				mockSessionRepository.EXPECT().FindByTokenHash("hashed-unknown-refresh-token").Return(entities.Session{}, domain.NewResourceNotFoundError("sessão não encontrada")).Times(1)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
+			})
+
+			It("result should be an empty struct", func() {
+				Expect(result).To(Equal(dtos.AuthDTO{}))
+			})
+
+			It("error should be an unauthorized error", func() {
+				Expect(refreshError).To(Equal(application.NewUnauthorizedError("refresh token inválido")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the Logout function", func() {
+		var claims providers.TokenClaims
+		var refreshToken string
+		var logoutError error
+		var authService services.AuthService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			logoutError = authService.Logout(claims, refreshToken)
+		})
+
+		When("the session is invalidated with success", func() {
+			BeforeEach(func() {
+				refreshToken = "refresh-token"
+
+				claims = providers.TokenClaims{
+					ID:        "5f1d7a3c9b2e4f60a1b2c3d4e5f60718",
+					UserID:    "6117e377b6e7bae09f52c483",
+					ExpiresAt: time.Now().Add(15 * time.Minute),
+				}
+
+				session := entities.Session{
+					ID:        "61f3e0b4c0b9a1a6d4a1e001",
+					UserID:    claims.UserID,
+					FamilyID:  "61f3e0b4c0b9a1a6d4a1e000",
+					TokenHash: "hashed-refresh-token",
+					ExpiresAt: time.Now().Add(time.Hour),
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+				mockTokenGenerator.EXPECT().Hash(refreshToken).Return("hashed-refresh-token").Times(1)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+				mockSessionRepository.EXPECT().FindByTokenHash("hashed-refresh-token").Return(session, nil).Times(1)
+				mockSessionRepository.EXPECT().RevokeFamily(session.FamilyID).Return(nil).Times(1)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+				mockRevocationStore.EXPECT().Revoke(claims.ID, claims.ExpiresAt).Return(nil).Times(1)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
+			})
+
+			It("error should be nil", func() {
+				Expect(logoutError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the refresh token belongs to another user", func() {
+			BeforeEach(func() {
+				refreshToken = "refresh-token"
+
+				claims = providers.TokenClaims{
+					ID:        "5f1d7a3c9b2e4f60a1b2c3d4e5f60718",
+					UserID:    "6117e377b6e7bae09f52c483",
+					ExpiresAt: time.Now().Add(15 * time.Minute),
+				}
+
+				session := entities.Session{
+					ID:        "61f3e0b4c0b9a1a6d4a1e001",
+					UserID:    "621f5e02e07fdbb81c8221f5",
+					FamilyID:  "61f3e0b4c0b9a1a6d4a1e000",
+					TokenHash: "hashed-refresh-token",
+					ExpiresAt: time.Now().Add(time.Hour),
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+				mockTokenGenerator.EXPECT().Hash(refreshToken).Return("hashed-refresh-token").Times(1)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+				mockSessionRepository.EXPECT().FindByTokenHash("hashed-refresh-token").Return(session, nil).Times(1)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
+			})
+
+			It("error should be an unauthorized error", func() {
+				Expect(logoutError).To(Equal(application.NewUnauthorizedError("refresh token inválido")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while revoking the access token", func() {
+			BeforeEach(func() {
+				refreshToken = "refresh-token"
+
+				claims = providers.TokenClaims{
+					ID:        "5f1d7a3c9b2e4f60a1b2c3d4e5f60718",
+					UserID:    "6117e377b6e7bae09f52c483",
+					ExpiresAt: time.Now().Add(15 * time.Minute),
+				}
+
+				session := entities.Session{
+					ID:        "61f3e0b4c0b9a1a6d4a1e001",
+					UserID:    claims.UserID,
+					FamilyID:  "61f3e0b4c0b9a1a6d4a1e000",
+					TokenHash: "hashed-refresh-token",
+					ExpiresAt: time.Now().Add(time.Hour),
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+				mockTokenGenerator.EXPECT().Hash(refreshToken).Return("hashed-refresh-token").Times(1)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+				mockSessionRepository.EXPECT().FindByTokenHash("hashed-refresh-token").Return(session, nil).Times(1)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+				mockRevocationStore.EXPECT().Revoke(claims.ID, claims.ExpiresAt).Return(errors.New("an error")).Times(1)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
+			})
+
+			It("error should be the error returned by the revocationStore.Revoke function", func() {
+				Expect(logoutError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while revoking the session", func() {
+			BeforeEach(func() {
+				refreshToken = "refresh-token"
+
+				claims = providers.TokenClaims{
+					ID:        "5f1d7a3c9b2e4f60a1b2c3d4e5f60718",
+					UserID:    "6117e377b6e7bae09f52c483",
+					ExpiresAt: time.Now().Add(15 * time.Minute),
+				}
+
+				session := entities.Session{
+					ID:        "61f3e0b4c0b9a1a6d4a1e001",
+					UserID:    claims.UserID,
+					FamilyID:  "61f3e0b4c0b9a1a6d4a1e000",
+					TokenHash: "hashed-refresh-token",
+					ExpiresAt: time.Now().Add(time.Hour),
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+				mockTokenGenerator.EXPECT().Hash(refreshToken).Return("hashed-refresh-token").Times(1)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+				mockSessionRepository.EXPECT().FindByTokenHash("hashed-refresh-token").Return(session, nil).Times(1)
+				mockSessionRepository.EXPECT().RevokeFamily(session.FamilyID).Return(errors.New("an error")).Times(1)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+				mockRevocationStore.EXPECT().Revoke(claims.ID, claims.ExpiresAt).Return(nil).Times(1)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
+			})
+
+			It("error should be the error returned by the sessionRepository.RevokeFamily function", func() {
+				Expect(logoutError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the ValidateToken function", func() {
+		var claims providers.TokenClaims
+		var validateError error
+		var authService services.AuthService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			validateError = authService.ValidateToken(claims)
+		})
+
+		When("the token is still valid", func() {
+			BeforeEach(func() {
+				claims = providers.TokenClaims{
+					ID:         "5f1d7a3c9b2e4f60a1b2c3d4e5f60718",
+					UserID:     "6117e377b6e7bae09f52c483",
+					Generation: 1,
+					ExpiresAt:  time.Now().Add(15 * time.Minute),
+				}
+
+				user := entities.User{
+					ID:              claims.UserID,
+					TokenGeneration: 1,
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(claims.UserID).Return(user, nil).Times(1)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+				mockRevocationStore.EXPECT().IsRevoked(claims.ID).Return(false, nil).Times(1)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
+			})
+
+			It("error should be nil", func() {
+				Expect(validateError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the token was revoked", func() {
+			BeforeEach(func() {
+				claims = providers.TokenClaims{
+					ID:         "5f1d7a3c9b2e4f60a1b2c3d4e5f60718",
+					UserID:     "6117e377b6e7bae09f52c483",
+					Generation: 1,
+					ExpiresAt:  time.Now().Add(15 * time.Minute),
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+				mockRevocationStore.EXPECT().IsRevoked(claims.ID).Return(true, nil).Times(1)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
+			})
+
+			It("error should be an unauthorized error", func() {
+				Expect(validateError).To(Equal(application.NewUnauthorizedError("token revogado")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user no longer exists", func() {
+			BeforeEach(func() {
+				claims = providers.TokenClaims{
+					ID:         "5f1d7a3c9b2e4f60a1b2c3d4e5f60718",
+					UserID:     "6117e377b6e7bae09f52c483",
+					Generation: 1,
+					ExpiresAt:  time.Now().Add(15 * time.Minute),
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(claims.UserID).Return(entities.User{}, domain.NewResourceNotFoundError("usuário não encontrado")).Times(1)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+				mockRevocationStore.EXPECT().IsRevoked(claims.ID).Return(false, nil).Times(1)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
+			})
+
+			It("error should be an unauthorized error", func() {
+				Expect(validateError).To(Equal(application.NewUnauthorizedError("token inválido")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the token predates the last password change", func() {
+			BeforeEach(func() {
+				claims = providers.TokenClaims{
+					ID:         "5f1d7a3c9b2e4f60a1b2c3d4e5f60718",
+					UserID:     "6117e377b6e7bae09f52c483",
+					Generation: 0,
+					ExpiresAt:  time.Now().Add(15 * time.Minute),
+				}
+
+				user := entities.User{
+					ID:              claims.UserID,
+					TokenGeneration: 1,
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(claims.UserID).Return(user, nil).Times(1)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+				mockRevocationStore.EXPECT().IsRevoked(claims.ID).Return(false, nil).Times(1)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
+			})
+
+			It("error should be an unauthorized error", func() {
+				Expect(validateError).To(Equal(application.NewUnauthorizedError("token revogado")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while checking the revocation store", func() {
+			BeforeEach(func() {
+				claims = providers.TokenClaims{
+					ID:         "5f1d7a3c9b2e4f60a1b2c3d4e5f60718",
+					UserID:     "6117e377b6e7bae09f52c483",
+					Generation: 1,
+					ExpiresAt:  time.Now().Add(15 * time.Minute),
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+
+				mockSessionRepository := repositoriesMocks.NewMockSessionRepository(mockCtrl)
+
+				mockRevocationStore := revocationMocks.NewMockRevocationStore(mockCtrl)
+				mockRevocationStore.EXPECT().IsRevoked(claims.ID).Return(false, errors.New("an error")).Times(1)
+
+				authService = services.NewAuthService(mockUserRepository, mockSessionRepository, mockSecurityProvider, mockAuthenticator, mockTokenGenerator, mockRevocationStore, authConfig)
+			})
+
+			It("error should be the error returned by the revocationStore.IsRevoked function", func() {
+				Expect(validateError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
 	})
 
 })
@@ -35,34 +35,109 @@ func (m *MockRoomService) EXPECT() *MockRoomServiceMockRecorder {
 	return m.recorder
 }
 
+// AddMember mocks base method.
+func (m *MockRoomService) AddMember(arg0, arg1 string, arg2 dtos.MemberDTO) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockRoomServiceMockRecorder) AddMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockRoomService)(nil).AddMember), arg0, arg1, arg2)
+}
+
+// ApproveQuestion mocks base method.
+func (m *MockRoomService) ApproveQuestion(arg0, arg1, arg2 string) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveQuestion", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveQuestion indicates an expected call of ApproveQuestion.
+func (mr *MockRoomServiceMockRecorder) ApproveQuestion(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveQuestion", reflect.TypeOf((*MockRoomService)(nil).ApproveQuestion), arg0, arg1, arg2)
+}
+
+// ClosePoll mocks base method.
+func (m *MockRoomService) ClosePoll(arg0, arg1, arg2 string) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClosePoll", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClosePoll indicates an expected call of ClosePoll.
+func (mr *MockRoomServiceMockRecorder) ClosePoll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClosePoll", reflect.TypeOf((*MockRoomService)(nil).ClosePoll), arg0, arg1, arg2)
+}
+
 // Create mocks base method.
-func (m *MockRoomService) Create(arg0 entities.Room) (entities.Room, error) {
+func (m *MockRoomService) Create(arg0 string, arg1 dtos.RoomDTO) (entities.Room, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
 	ret0, _ := ret[0].(entities.Room)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockRoomServiceMockRecorder) Create(arg0 interface{}) *gomock.Call {
+func (mr *MockRoomServiceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoomService)(nil).Create), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoomService)(nil).Create), arg0, arg1)
+}
+
+// CreatePoll mocks base method.
+func (m *MockRoomService) CreatePoll(arg0, arg1 string, arg2 dtos.PollDTO) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePoll", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePoll indicates an expected call of CreatePoll.
+func (mr *MockRoomServiceMockRecorder) CreatePoll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePoll", reflect.TypeOf((*MockRoomService)(nil).CreatePoll), arg0, arg1, arg2)
 }
 
 // CreateQuestion mocks base method.
-func (m *MockRoomService) CreateQuestion(arg0 string, arg1 entities.Question) (entities.Room, error) {
+func (m *MockRoomService) CreateQuestion(arg0, arg1 string, arg2 dtos.QuestionDTO, arg3 string) (entities.Room, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateQuestion", arg0, arg1)
+	ret := m.ctrl.Call(m, "CreateQuestion", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(entities.Room)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateQuestion indicates an expected call of CreateQuestion.
-func (mr *MockRoomServiceMockRecorder) CreateQuestion(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockRoomServiceMockRecorder) CreateQuestion(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQuestion", reflect.TypeOf((*MockRoomService)(nil).CreateQuestion), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQuestion", reflect.TypeOf((*MockRoomService)(nil).CreateQuestion), arg0, arg1, arg2, arg3)
+}
+
+// CreateReply mocks base method.
+func (m *MockRoomService) CreateReply(arg0, arg1, arg2 string, arg3 dtos.ReplyDTO, arg4 string) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReply", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReply indicates an expected call of CreateReply.
+func (mr *MockRoomServiceMockRecorder) CreateReply(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReply", reflect.TypeOf((*MockRoomService)(nil).CreateReply), arg0, arg1, arg2, arg3, arg4)
 }
 
 // DeleteQuestion mocks base method.
@@ -80,19 +155,63 @@ func (mr *MockRoomServiceMockRecorder) DeleteQuestion(arg0, arg1, arg2 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQuestion", reflect.TypeOf((*MockRoomService)(nil).DeleteQuestion), arg0, arg1, arg2)
 }
 
+// DeleteReply mocks base method.
+func (m *MockRoomService) DeleteReply(arg0, arg1, arg2, arg3 string) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReply", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReply indicates an expected call of DeleteReply.
+func (mr *MockRoomServiceMockRecorder) DeleteReply(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReply", reflect.TypeOf((*MockRoomService)(nil).DeleteReply), arg0, arg1, arg2, arg3)
+}
+
 // DeslikeQuestion mocks base method.
-func (m *MockRoomService) DeslikeQuestion(arg0, arg1, arg2 string) (entities.Room, error) {
+func (m *MockRoomService) DeslikeQuestion(arg0, arg1, arg2, arg3 string) (entities.Room, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeslikeQuestion", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "DeslikeQuestion", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(entities.Room)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeslikeQuestion indicates an expected call of DeslikeQuestion.
-func (mr *MockRoomServiceMockRecorder) DeslikeQuestion(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockRoomServiceMockRecorder) DeslikeQuestion(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeslikeQuestion", reflect.TypeOf((*MockRoomService)(nil).DeslikeQuestion), arg0, arg1, arg2, arg3)
+}
+
+// EditQuestion mocks base method.
+func (m *MockRoomService) EditQuestion(arg0, arg1, arg2 string, arg3 dtos.EditQuestionDTO) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditQuestion", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditQuestion indicates an expected call of EditQuestion.
+func (mr *MockRoomServiceMockRecorder) EditQuestion(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeslikeQuestion", reflect.TypeOf((*MockRoomService)(nil).DeslikeQuestion), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditQuestion", reflect.TypeOf((*MockRoomService)(nil).EditQuestion), arg0, arg1, arg2, arg3)
+}
+
+// EndDueRooms mocks base method.
+func (m *MockRoomService) EndDueRooms() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndDueRooms")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndDueRooms indicates an expected call of EndDueRooms.
+func (mr *MockRoomServiceMockRecorder) EndDueRooms() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndDueRooms", reflect.TypeOf((*MockRoomService)(nil).EndDueRooms))
 }
 
 // EndRoom mocks base method.
@@ -110,34 +229,214 @@ func (mr *MockRoomServiceMockRecorder) EndRoom(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndRoom", reflect.TypeOf((*MockRoomService)(nil).EndRoom), arg0, arg1)
 }
 
+// FindAll mocks base method.
+func (m *MockRoomService) FindAll(arg0 string, arg1 dtos.RoomQueryDTO) (dtos.RoomPageDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1)
+	ret0, _ := ret[0].(dtos.RoomPageDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockRoomServiceMockRecorder) FindAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockRoomService)(nil).FindAll), arg0, arg1)
+}
+
+// FindByCode mocks base method.
+func (m *MockRoomService) FindByCode(arg0, arg1, arg2 string) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCode", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCode indicates an expected call of FindByCode.
+func (mr *MockRoomServiceMockRecorder) FindByCode(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCode", reflect.TypeOf((*MockRoomService)(nil).FindByCode), arg0, arg1, arg2)
+}
+
 // FindByID mocks base method.
-func (m *MockRoomService) FindByID(arg0 string) (entities.Room, error) {
+func (m *MockRoomService) FindByID(arg0, arg1, arg2 string) (entities.Room, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByID", arg0)
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1, arg2)
 	ret0, _ := ret[0].(entities.Room)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByID indicates an expected call of FindByID.
-func (mr *MockRoomServiceMockRecorder) FindByID(arg0 interface{}) *gomock.Call {
+func (mr *MockRoomServiceMockRecorder) FindByID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockRoomService)(nil).FindByID), arg0, arg1, arg2)
+}
+
+// FindDeletedQuestions mocks base method.
+func (m *MockRoomService) FindDeletedQuestions(arg0, arg1 string, arg2 dtos.QuestionQueryDTO) (dtos.QuestionPageDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedQuestions", arg0, arg1, arg2)
+	ret0, _ := ret[0].(dtos.QuestionPageDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedQuestions indicates an expected call of FindDeletedQuestions.
+func (mr *MockRoomServiceMockRecorder) FindDeletedQuestions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedQuestions", reflect.TypeOf((*MockRoomService)(nil).FindDeletedQuestions), arg0, arg1, arg2)
+}
+
+// FindPendingQuestions mocks base method.
+func (m *MockRoomService) FindPendingQuestions(arg0, arg1 string, arg2 dtos.QuestionQueryDTO) (dtos.QuestionPageDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPendingQuestions", arg0, arg1, arg2)
+	ret0, _ := ret[0].(dtos.QuestionPageDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPendingQuestions indicates an expected call of FindPendingQuestions.
+func (mr *MockRoomServiceMockRecorder) FindPendingQuestions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingQuestions", reflect.TypeOf((*MockRoomService)(nil).FindPendingQuestions), arg0, arg1, arg2)
+}
+
+// FindQuestionRevisions mocks base method.
+func (m *MockRoomService) FindQuestionRevisions(arg0, arg1, arg2 string) ([]entities.QuestionRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindQuestionRevisions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]entities.QuestionRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindQuestionRevisions indicates an expected call of FindQuestionRevisions.
+func (mr *MockRoomServiceMockRecorder) FindQuestionRevisions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindQuestionRevisions", reflect.TypeOf((*MockRoomService)(nil).FindQuestionRevisions), arg0, arg1, arg2)
+}
+
+// FindQuestions mocks base method.
+func (m *MockRoomService) FindQuestions(arg0, arg1 string, arg2 dtos.QuestionQueryDTO, arg3 string) (dtos.QuestionPageDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindQuestions", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(dtos.QuestionPageDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindQuestions indicates an expected call of FindQuestions.
+func (mr *MockRoomServiceMockRecorder) FindQuestions(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindQuestions", reflect.TypeOf((*MockRoomService)(nil).FindQuestions), arg0, arg1, arg2, arg3)
+}
+
+// FindSummaryByID mocks base method.
+func (m *MockRoomService) FindSummaryByID(arg0, arg1, arg2 string) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSummaryByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSummaryByID indicates an expected call of FindSummaryByID.
+func (mr *MockRoomServiceMockRecorder) FindSummaryByID(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockRoomService)(nil).FindByID), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSummaryByID", reflect.TypeOf((*MockRoomService)(nil).FindSummaryByID), arg0, arg1, arg2)
+}
+
+// JoinRoom mocks base method.
+func (m *MockRoomService) JoinRoom(arg0, arg1 string, arg2 dtos.JoinRoomDTO) (dtos.RoomGrantDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinRoom", arg0, arg1, arg2)
+	ret0, _ := ret[0].(dtos.RoomGrantDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinRoom indicates an expected call of JoinRoom.
+func (mr *MockRoomServiceMockRecorder) JoinRoom(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinRoom", reflect.TypeOf((*MockRoomService)(nil).JoinRoom), arg0, arg1, arg2)
 }
 
 // LikeQuestion mocks base method.
-func (m *MockRoomService) LikeQuestion(arg0, arg1 string, arg2 entities.Like) (entities.Room, error) {
+func (m *MockRoomService) LikeQuestion(arg0, arg1, arg2, arg3 string) (entities.Room, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LikeQuestion", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "LikeQuestion", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(entities.Room)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // LikeQuestion indicates an expected call of LikeQuestion.
-func (mr *MockRoomServiceMockRecorder) LikeQuestion(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockRoomServiceMockRecorder) LikeQuestion(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikeQuestion", reflect.TypeOf((*MockRoomService)(nil).LikeQuestion), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikeQuestion", reflect.TypeOf((*MockRoomService)(nil).LikeQuestion), arg0, arg1, arg2, arg3)
+}
+
+// OpenPoll mocks base method.
+func (m *MockRoomService) OpenPoll(arg0, arg1, arg2 string) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenPoll", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenPoll indicates an expected call of OpenPoll.
+func (mr *MockRoomServiceMockRecorder) OpenPoll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenPoll", reflect.TypeOf((*MockRoomService)(nil).OpenPoll), arg0, arg1, arg2)
+}
+
+// RejectQuestion mocks base method.
+func (m *MockRoomService) RejectQuestion(arg0, arg1, arg2 string) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectQuestion", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectQuestion indicates an expected call of RejectQuestion.
+func (mr *MockRoomServiceMockRecorder) RejectQuestion(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectQuestion", reflect.TypeOf((*MockRoomService)(nil).RejectQuestion), arg0, arg1, arg2)
+}
+
+// RemoveMember mocks base method.
+func (m *MockRoomService) RemoveMember(arg0, arg1, arg2 string) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockRoomServiceMockRecorder) RemoveMember(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockRoomService)(nil).RemoveMember), arg0, arg1, arg2)
+}
+
+// ReopenRoom mocks base method.
+func (m *MockRoomService) ReopenRoom(arg0, arg1 string) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReopenRoom", arg0, arg1)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReopenRoom indicates an expected call of ReopenRoom.
+func (mr *MockRoomServiceMockRecorder) ReopenRoom(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReopenRoom", reflect.TypeOf((*MockRoomService)(nil).ReopenRoom), arg0, arg1)
 }
 
 // UpdateQuestion mocks base method.
@@ -154,3 +453,48 @@ func (mr *MockRoomServiceMockRecorder) UpdateQuestion(arg0, arg1, arg2, arg3 int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuestion", reflect.TypeOf((*MockRoomService)(nil).UpdateQuestion), arg0, arg1, arg2, arg3)
 }
+
+// UpdateReply mocks base method.
+func (m *MockRoomService) UpdateReply(arg0, arg1, arg2, arg3 string, arg4 dtos.UpdateReplyDTO) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReply", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReply indicates an expected call of UpdateReply.
+func (mr *MockRoomServiceMockRecorder) UpdateReply(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReply", reflect.TypeOf((*MockRoomService)(nil).UpdateReply), arg0, arg1, arg2, arg3, arg4)
+}
+
+// UpdateSettings mocks base method.
+func (m *MockRoomService) UpdateSettings(arg0, arg1 string, arg2 dtos.RoomSettingsDTO) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSettings", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSettings indicates an expected call of UpdateSettings.
+func (mr *MockRoomServiceMockRecorder) UpdateSettings(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSettings", reflect.TypeOf((*MockRoomService)(nil).UpdateSettings), arg0, arg1, arg2)
+}
+
+// Vote mocks base method.
+func (m *MockRoomService) Vote(arg0, arg1, arg2 string, arg3 dtos.VoteDTO, arg4 string) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Vote", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Vote indicates an expected call of Vote.
+func (mr *MockRoomServiceMockRecorder) Vote(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vote", reflect.TypeOf((*MockRoomService)(nil).Vote), arg0, arg1, arg2, arg3, arg4)
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/waliqueiroz/letmeask-api/internal/application/dtos"
+	"github.com/waliqueiroz/letmeask-api/internal/application/providers"
+	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+	domain "github.com/waliqueiroz/letmeask-api/internal/domain/errors"
+	"github.com/waliqueiroz/letmeask-api/internal/domain/repositories"
+)
+
+type PasswordService interface {
+	ForgotPassword(forgotPassword dtos.ForgotPasswordDTO) error
+	ResetPassword(resetPassword dtos.ResetPasswordDTO) error
+}
+
+type PasswordConfig struct {
+	ResetTokenTTL time.Duration
+	ResetURL      string
+}
+
+type passwordService struct {
+	userRepository          repositories.UserRepository
+	passwordResetRepository repositories.PasswordResetRepository
+	securityProvider        providers.SecurityProvider
+	tokenGenerator          providers.TokenGenerator
+	mailer                  providers.Mailer
+	config                  PasswordConfig
+}
+
+func NewPasswordService(userRepository repositories.UserRepository, passwordResetRepository repositories.PasswordResetRepository, securityProvider providers.SecurityProvider, tokenGenerator providers.TokenGenerator, mailer providers.Mailer, config PasswordConfig) *passwordService {
+	return &passwordService{
+		userRepository,
+		passwordResetRepository,
+		securityProvider,
+		tokenGenerator,
+		mailer,
+		config,
+	}
+}
+
+func (service *passwordService) ForgotPassword(forgotPassword dtos.ForgotPasswordDTO) error {
+	user, err := service.userRepository.FindByEmail(forgotPassword.Email)
+	if err != nil {
+		// Answering the same way for unknown addresses keeps this endpoint
+		// from revealing which emails have an account.
+		var notFoundError *domain.ResourceNotFoundError
+		if errors.As(err, &notFoundError) {
+			return nil
+		}
+
+		return err
+	}
+
+	token, err := service.tokenGenerator.Generate()
+	if err != nil {
+		return err
+	}
+
+	_, err = service.passwordResetRepository.Create(entities.PasswordReset{
+		UserID:    user.ID,
+		TokenHash: service.tokenGenerator.Hash(token),
+		ExpiresAt: time.Now().Add(service.config.ResetTokenTTL),
+	})
+	if err != nil {
+		return err
+	}
+
+	return service.mailer.Send(dtos.MailDTO{
+		To:      user.Email,
+		Subject: "Redefinição de senha",
+		Body:    service.buildResetMessage(user, token),
+	})
+}
+
+func (service *passwordService) ResetPassword(resetPassword dtos.ResetPasswordDTO) error {
+	invalidTokenError := domain.NewBadRequestError("token de redefinição inválido ou expirado")
+
+	reset, err := service.passwordResetRepository.FindByTokenHash(service.tokenGenerator.Hash(resetPassword.Token))
+	if err != nil {
+		var notFoundError *domain.ResourceNotFoundError
+		if errors.As(err, &notFoundError) {
+			return invalidTokenError
+		}
+
+		return err
+	}
+
+	if !reset.IsValid() {
+		return invalidTokenError
+	}
+
+	if err := service.passwordResetRepository.MarkAsUsed(reset.ID); err != nil {
+		var conflictError *domain.ConflictError
+		if errors.As(err, &conflictError) {
+			return invalidTokenError
+		}
+
+		return err
+	}
+
+	hashedPassword, err := service.securityProvider.Hash(resetPassword.Password)
+	if err != nil {
+		return err
+	}
+
+	return service.userRepository.UpdatePassword(reset.UserID, string(hashedPassword))
+}
+
+func (service *passwordService) buildResetMessage(user entities.User, token string) string {
+	link := fmt.Sprintf("%s?token=%s", service.config.ResetURL, url.QueryEscape(token))
+
+	return fmt.Sprintf(
+		"Olá, %s!\n\nRecebemos um pedido para redefinir a sua senha. Para escolher uma nova senha, acesse o link abaixo:\n\n%s\n\nO link expira em %s. Se você não fez esse pedido, ignore este e-mail.\n",
+		user.Name,
+		link,
+		service.config.ResetTokenTTL,
+	)
+}
@@ -0,0 +1,327 @@
+package services_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/waliqueiroz/letmeask-api/internal/application/dtos"
+	"github.com/waliqueiroz/letmeask-api/internal/application/services"
+	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+	domain "github.com/waliqueiroz/letmeask-api/internal/domain/errors"
+	authMocks "github.com/waliqueiroz/letmeask-api/internal/infrastructure/authentication/mocks"
+	repositoriesMocks "github.com/waliqueiroz/letmeask-api/internal/infrastructure/database/mongodb/repositories/mocks"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/mail/memory"
+	securityMocks "github.com/waliqueiroz/letmeask-api/internal/infrastructure/security/mocks"
+)
+
+var _ = Describe("Password", func() {
+	passwordConfig := services.PasswordConfig{
+		ResetTokenTTL: time.Hour,
+		ResetURL:      "https://letmeask.test/reset-password",
+	}
+
+	Describe("Executing the ForgotPassword function", func() {
+		var forgotPassword dtos.ForgotPasswordDTO
+		var forgotPasswordError error
+		var mailProvider *memory.MemoryProvider
+		var passwordService services.PasswordService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			forgotPasswordError = passwordService.ForgotPassword(forgotPassword)
+		})
+
+		When("the reset link is sent with success", func() {
+			var user entities.User
+
+			BeforeEach(func() {
+				userSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(userSerialized, &user)
+				Expect(err).NotTo(HaveOccurred())
+
+				forgotPassword = dtos.ForgotPasswordDTO{Email: user.Email}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByEmail(user.Email).Return(user, nil).Times(1)
+
+				mockPasswordResetRepository := repositoriesMocks.NewMockPasswordResetRepository(mockCtrl)
+				mockPasswordResetRepository.EXPECT().Create(gomock.AssignableToTypeOf(entities.PasswordReset{})).Do(func(reset entities.PasswordReset) {
+					Expect(reset.UserID).To(Equal(user.ID))
+					Expect(reset.TokenHash).To(Equal("hashed-reset-token"))
+					Expect(reset.ExpiresAt).To(BeTemporally("~", time.Now().Add(passwordConfig.ResetTokenTTL), time.Second))
+				}).Return(entities.PasswordReset{}, nil).Times(1)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+				mockTokenGenerator.EXPECT().Generate().Return("reset-token", nil).Times(1)
+				mockTokenGenerator.EXPECT().Hash("reset-token").Return("hashed-reset-token").Times(1)
+
+				mailProvider = memory.NewMemoryProvider()
+
+				passwordService = services.NewPasswordService(mockUserRepository, mockPasswordResetRepository, mockSecurityProvider, mockTokenGenerator, mailProvider, passwordConfig)
+			})
+
+			It("should send a single mail to the user", func() {
+				Expect(mailProvider.Sent()).To(HaveLen(1))
+				Expect(mailProvider.Sent()[0].To).To(Equal(user.Email))
+			})
+
+			It("mail body should contain the reset link", func() {
+				Expect(mailProvider.Sent()[0].Body).To(ContainSubstring("https://letmeask.test/reset-password?token=reset-token"))
+			})
+
+			It("error should be nil", func() {
+				Expect(forgotPasswordError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("there is no user with the given email", func() {
+			BeforeEach(func() {
+				forgotPassword = dtos.ForgotPasswordDTO{Email: "unknown@mail.com"}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByEmail(forgotPassword.Email).Return(entities.User{}, domain.NewResourceNotFoundError("usuário não encontrado")).Times(1)
+
+				mockPasswordResetRepository := repositoriesMocks.NewMockPasswordResetRepository(mockCtrl)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+
+				mailProvider = memory.NewMemoryProvider()
+
+				passwordService = services.NewPasswordService(mockUserRepository, mockPasswordResetRepository, mockSecurityProvider, mockTokenGenerator, mailProvider, passwordConfig)
+			})
+
+			It("should not send any mail", func() {
+				Expect(mailProvider.Sent()).To(BeEmpty())
+			})
+
+			It("error should be nil", func() {
+				Expect(forgotPasswordError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while storing the reset token", func() {
+			BeforeEach(func() {
+				user := entities.User{
+					ID:    "6117e377b6e7bae09f52c483",
+					Email: "teste1@mail.com",
+				}
+
+				forgotPassword = dtos.ForgotPasswordDTO{Email: user.Email}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByEmail(user.Email).Return(user, nil).Times(1)
+
+				mockPasswordResetRepository := repositoriesMocks.NewMockPasswordResetRepository(mockCtrl)
+				mockPasswordResetRepository.EXPECT().Create(gomock.AssignableToTypeOf(entities.PasswordReset{})).Return(entities.PasswordReset{}, errors.New("an error")).Times(1)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+				mockTokenGenerator.EXPECT().Generate().Return("reset-token", nil).Times(1)
+				mockTokenGenerator.EXPECT().Hash("reset-token").Return("hashed-reset-token").Times(1)
+
+				mailProvider = memory.NewMemoryProvider()
+
+				passwordService = services.NewPasswordService(mockUserRepository, mockPasswordResetRepository, mockSecurityProvider, mockTokenGenerator, mailProvider, passwordConfig)
+			})
+
+			It("should not send any mail", func() {
+				Expect(mailProvider.Sent()).To(BeEmpty())
+			})
+
+			It("error should be the error returned by the passwordResetRepository.Create function", func() {
+				Expect(forgotPasswordError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the ResetPassword function", func() {
+		var resetPassword dtos.ResetPasswordDTO
+		var resetPasswordError error
+		var passwordService services.PasswordService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			resetPasswordError = passwordService.ResetPassword(resetPassword)
+		})
+
+		When("the password is reset with success", func() {
+			BeforeEach(func() {
+				resetPassword = dtos.ResetPasswordDTO{
+					Token:    "reset-token",
+					Password: "n0v4-s3nh4",
+				}
+
+				reset := entities.PasswordReset{
+					ID:        "61f3e0b4c0b9a1a6d4a1e101",
+					UserID:    "6117e377b6e7bae09f52c483",
+					TokenHash: "hashed-reset-token",
+					ExpiresAt: time.Now().Add(time.Hour),
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().UpdatePassword(reset.UserID, "hashed-password").Return(nil).Times(1)
+
+				mockPasswordResetRepository := repositoriesMocks.NewMockPasswordResetRepository(mockCtrl)
+				mockPasswordResetRepository.EXPECT().FindByTokenHash("hashed-reset-token").Return(reset, nil).Times(1)
+				mockPasswordResetRepository.EXPECT().MarkAsUsed(reset.ID).Return(nil).Times(1)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+				mockSecurityProvider.EXPECT().Hash(resetPassword.Password).Return("hashed-password", nil).Times(1)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+				mockTokenGenerator.EXPECT().Hash(resetPassword.Token).Return("hashed-reset-token").Times(1)
+
+				passwordService = services.NewPasswordService(mockUserRepository, mockPasswordResetRepository, mockSecurityProvider, mockTokenGenerator, memory.NewMemoryProvider(), passwordConfig)
+			})
+
+			It("error should be nil", func() {
+				Expect(resetPasswordError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the reset token does not exist", func() {
+			BeforeEach(func() {
+				resetPassword = dtos.ResetPasswordDTO{
+					Token:    "unknown-reset-token",
+					Password: "n0v4-s3nh4",
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockPasswordResetRepository := repositoriesMocks.NewMockPasswordResetRepository(mockCtrl)
+				mockPasswordResetRepository.EXPECT().FindByTokenHash("hashed-unknown-reset-token").Return(entities.PasswordReset{}, domain.NewResourceNotFoundError("redefinição de senha não encontrada")).Times(1)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+				mockTokenGenerator.EXPECT().Hash(resetPassword.Token).Return("hashed-unknown-reset-token").Times(1)
+
+				passwordService = services.NewPasswordService(mockUserRepository, mockPasswordResetRepository, mockSecurityProvider, mockTokenGenerator, memory.NewMemoryProvider(), passwordConfig)
+			})
+
+			It("error should be a bad request error", func() {
+				Expect(resetPasswordError).To(Equal(domain.NewBadRequestError("token de redefinição inválido ou expirado")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the reset token is expired", func() {
+			BeforeEach(func() {
+				resetPassword = dtos.ResetPasswordDTO{
+					Token:    "reset-token",
+					Password: "n0v4-s3nh4",
+				}
+
+				reset := entities.PasswordReset{
+					ID:        "61f3e0b4c0b9a1a6d4a1e101",
+					UserID:    "6117e377b6e7bae09f52c483",
+					TokenHash: "hashed-reset-token",
+					ExpiresAt: time.Now().Add(-time.Minute),
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockPasswordResetRepository := repositoriesMocks.NewMockPasswordResetRepository(mockCtrl)
+				mockPasswordResetRepository.EXPECT().FindByTokenHash("hashed-reset-token").Return(reset, nil).Times(1)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+				mockTokenGenerator.EXPECT().Hash(resetPassword.Token).Return("hashed-reset-token").Times(1)
+
+				passwordService = services.NewPasswordService(mockUserRepository, mockPasswordResetRepository, mockSecurityProvider, mockTokenGenerator, memory.NewMemoryProvider(), passwordConfig)
+			})
+
+			It("error should be a bad request error", func() {
+				Expect(resetPasswordError).To(Equal(domain.NewBadRequestError("token de redefinição inválido ou expirado")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the reset token was already used", func() {
+			BeforeEach(func() {
+				resetPassword = dtos.ResetPasswordDTO{
+					Token:    "reset-token",
+					Password: "n0v4-s3nh4",
+				}
+
+				reset := entities.PasswordReset{
+					ID:        "61f3e0b4c0b9a1a6d4a1e101",
+					UserID:    "6117e377b6e7bae09f52c483",
+					TokenHash: "hashed-reset-token",
+					ExpiresAt: time.Now().Add(time.Hour),
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockPasswordResetRepository := repositoriesMocks.NewMockPasswordResetRepository(mockCtrl)
+				mockPasswordResetRepository.EXPECT().FindByTokenHash("hashed-reset-token").Return(reset, nil).Times(1)
+				mockPasswordResetRepository.EXPECT().MarkAsUsed(reset.ID).Return(domain.NewConflictError("o token de redefinição já foi utilizado")).Times(1)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockTokenGenerator := authMocks.NewMockTokenGenerator(mockCtrl)
+				mockTokenGenerator.EXPECT().Hash(resetPassword.Token).Return("hashed-reset-token").Times(1)
+
+				passwordService = services.NewPasswordService(mockUserRepository, mockPasswordResetRepository, mockSecurityProvider, mockTokenGenerator, memory.NewMemoryProvider(), passwordConfig)
+			})
+
+			It("error should be a bad request error", func() {
+				Expect(resetPasswordError).To(Equal(domain.NewBadRequestError("token de redefinição inválido ou expirado")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+})
@@ -1,68 +1,602 @@
 package services
 
 import (
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/waliqueiroz/letmeask-api/internal/application/dtos"
 	application "github.com/waliqueiroz/letmeask-api/internal/application/errors"
+	"github.com/waliqueiroz/letmeask-api/internal/application/policies"
+	"github.com/waliqueiroz/letmeask-api/internal/application/providers"
 	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+	domain "github.com/waliqueiroz/letmeask-api/internal/domain/errors"
 	"github.com/waliqueiroz/letmeask-api/internal/domain/repositories"
 )
 
+const maxUpdateAttempts = 3
+
+const defaultPageSize = 20
+
 type RoomService interface {
-	Create(room entities.Room) (entities.Room, error)
-	FindByID(roomID string) (entities.Room, error)
+	Create(userID string, roomDTO dtos.RoomDTO) (entities.Room, error)
+	FindAll(viewerID string, query dtos.RoomQueryDTO) (dtos.RoomPageDTO, error)
+	FindByID(viewerID string, roomID string, grant string) (entities.Room, error)
+	FindByCode(viewerID string, code string, grant string) (entities.Room, error)
+	FindSummaryByID(viewerID string, roomID string, grant string) (entities.Room, error)
+	JoinRoom(userID string, roomID string, joinDTO dtos.JoinRoomDTO) (dtos.RoomGrantDTO, error)
+	EndDueRooms() error
+	FindQuestions(viewerID string, roomID string, query dtos.QuestionQueryDTO, grant string) (dtos.QuestionPageDTO, error)
+	FindPendingQuestions(userID string, roomID string, query dtos.QuestionQueryDTO) (dtos.QuestionPageDTO, error)
+	FindDeletedQuestions(userID string, roomID string, query dtos.QuestionQueryDTO) (dtos.QuestionPageDTO, error)
 	EndRoom(userID string, roomID string) (entities.Room, error)
-	CreateQuestion(roomID string, question entities.Question) (entities.Room, error)
-	LikeQuestion(roomID string, questionID string, like entities.Like) (entities.Room, error)
-	DeslikeQuestion(roomID string, questionID string, likeID string) (entities.Room, error)
+	ReopenRoom(userID string, roomID string) (entities.Room, error)
+	UpdateSettings(userID string, roomID string, settingsDTO dtos.RoomSettingsDTO) (entities.Room, error)
+	CreateQuestion(userID string, roomID string, questionDTO dtos.QuestionDTO, grant string) (entities.Room, error)
+	LikeQuestion(userID string, roomID string, questionID string, grant string) (entities.Room, error)
+	DeslikeQuestion(userID string, roomID string, questionID string, likeID string) (entities.Room, error)
 	UpdateQuestion(userID string, roomID string, questionID string, questionData dtos.UpdateQuestionDTO) (entities.Room, error)
+	EditQuestion(userID string, roomID string, questionID string, questionDTO dtos.EditQuestionDTO) (entities.Room, error)
+	FindQuestionRevisions(userID string, roomID string, questionID string) ([]entities.QuestionRevision, error)
 	DeleteQuestion(userID string, roomID string, questionID string) (entities.Room, error)
+	ApproveQuestion(userID string, roomID string, questionID string) (entities.Room, error)
+	RejectQuestion(userID string, roomID string, questionID string) (entities.Room, error)
+	CreateReply(userID string, roomID string, questionID string, replyDTO dtos.ReplyDTO, grant string) (entities.Room, error)
+	UpdateReply(userID string, roomID string, questionID string, replyID string, replyData dtos.UpdateReplyDTO) (entities.Room, error)
+	DeleteReply(userID string, roomID string, questionID string, replyID string) (entities.Room, error)
+	CreatePoll(userID string, roomID string, pollDTO dtos.PollDTO) (entities.Room, error)
+	OpenPoll(userID string, roomID string, pollID string) (entities.Room, error)
+	ClosePoll(userID string, roomID string, pollID string) (entities.Room, error)
+	Vote(userID string, roomID string, pollID string, voteDTO dtos.VoteDTO, grant string) (entities.Room, error)
+	AddMember(userID string, roomID string, memberDTO dtos.MemberDTO) (entities.Room, error)
+	RemoveMember(userID string, roomID string, memberID string) (entities.Room, error)
+}
+
+type RoomConfig struct {
+	// QuestionEditWindow is how long authors have to edit their questions
+	// after asking them. Zero means there is no time limit.
+	QuestionEditWindow time.Duration
+	// AnswerGracePeriod is how long after the end of a room its owner can
+	// still mark questions as answered.
+	AnswerGracePeriod time.Duration
+	// AccessGrantTTL is how long the grant to enter a private room lasts.
+	AccessGrantTTL time.Duration
 }
 
 type roomService struct {
-	roomRepository repositories.RoomRepository
+	roomRepository   repositories.RoomRepository
+	userRepository   repositories.UserRepository
+	securityProvider providers.SecurityProvider
+	signer           providers.Signer
+	broadcaster      providers.Broadcaster
+	roomPolicy       policies.RoomPolicy
+	config           RoomConfig
 }
 
-func NewRoomService(roomRepository repositories.RoomRepository) *roomService {
+func NewRoomService(roomRepository repositories.RoomRepository, userRepository repositories.UserRepository, securityProvider providers.SecurityProvider, signer providers.Signer, broadcaster providers.Broadcaster, roomPolicy policies.RoomPolicy, config RoomConfig) *roomService {
 	return &roomService{
 		roomRepository,
+		userRepository,
+		securityProvider,
+		signer,
+		broadcaster,
+		roomPolicy,
+		config,
 	}
 }
 
-func (service *roomService) Create(room entities.Room) (entities.Room, error) {
+func (service *roomService) Create(userID string, roomDTO dtos.RoomDTO) (entities.Room, error) {
+	user, err := service.userRepository.FindByID(userID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if err := service.roomPolicy.CanCreate(user); err != nil {
+		return entities.Room{}, err
+	}
+
+	if roomDTO.ScheduledEndAt != nil {
+		if !roomDTO.ScheduledEndAt.After(time.Now()) {
+			return entities.Room{}, domain.NewBadRequestError("o encerramento agendado deve estar no futuro.")
+		}
+
+		if roomDTO.ScheduledStartAt != nil && !roomDTO.ScheduledEndAt.After(*roomDTO.ScheduledStartAt) {
+			return entities.Room{}, domain.NewBadRequestError("o encerramento agendado deve ser posterior à abertura.")
+		}
+	}
+
+	room := entities.Room{
+		Title:  roomDTO.Title,
+		Author: user.ToAuthor(),
+		Members: []entities.Member{
+			{User: user.ToAuthor(), Role: entities.RoomRoleOwner, AddedAt: time.Now()},
+		},
+		ScheduledStartAt: roomDTO.ScheduledStartAt,
+		ScheduledEndAt:   roomDTO.ScheduledEndAt,
+	}
+
 	return service.roomRepository.Create(room)
 }
 
-func (service *roomService) FindByID(roomID string) (entities.Room, error) {
-	return service.roomRepository.FindByID(roomID)
+// FindAll only lists the private rooms the viewer belongs to, since anyone
+// else would have to join them first.
+func (service *roomService) FindAll(viewerID string, query dtos.RoomQueryDTO) (dtos.RoomPageDTO, error) {
+	filter := repositories.RoomFilter{
+		ViewerID:   viewerID,
+		AuthorID:   query.AuthorID,
+		Status:     query.Status,
+		Search:     query.Search,
+		SortBy:     strings.TrimPrefix(query.Sort, "-"),
+		Descending: query.Sort == "" || strings.HasPrefix(query.Sort, "-"),
+		Cursor:     query.Cursor,
+		Limit:      query.Limit,
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = defaultPageSize
+	}
+
+	if query.CreatedFrom != "" {
+		createdFrom, err := time.Parse(time.RFC3339, query.CreatedFrom)
+		if err != nil {
+			return dtos.RoomPageDTO{}, err
+		}
+
+		filter.CreatedFrom = &createdFrom
+	}
+
+	if query.CreatedTo != "" {
+		createdTo, err := time.Parse(time.RFC3339, query.CreatedTo)
+		if err != nil {
+			return dtos.RoomPageDTO{}, err
+		}
+
+		filter.CreatedTo = &createdTo
+	}
+
+	page, err := service.roomRepository.FindAll(filter)
+	if err != nil {
+		return dtos.RoomPageDTO{}, err
+	}
+
+	// Who takes part in a room is for the room itself to tell, not the list.
+	for index, room := range page.Rooms {
+		room = service.present(room, viewerID)
+		room.Members = nil
+
+		page.Rooms[index] = room
+	}
+
+	return dtos.RoomPageDTO{
+		Rooms:      page.Rooms,
+		NextCursor: page.NextCursor,
+	}, nil
 }
 
-func (service *roomService) EndRoom(userID string, roomID string) (entities.Room, error) {
+func (service *roomService) FindByID(viewerID string, roomID string, grant string) (entities.Room, error) {
 	room, err := service.roomRepository.FindByID(roomID)
 	if err != nil {
 		return entities.Room{}, err
 	}
 
-	if userID != room.Author.ID {
-		return entities.Room{}, application.NewForbiddenError("você não pode encerrar uma sala que não é sua.")
+	if err := service.authorizeAccess(room, viewerID, grant); err != nil {
+		return entities.Room{}, err
 	}
 
-	now := time.Now()
-	room.EndedAt = &now
+	return service.present(room, viewerID), nil
+}
+
+func (service *roomService) FindByCode(viewerID string, code string, grant string) (entities.Room, error) {
+	room, err := service.roomRepository.FindByCode(code)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if err := service.authorizeAccess(room, viewerID, grant); err != nil {
+		return entities.Room{}, err
+	}
+
+	return service.present(room, viewerID), nil
+}
+
+func (service *roomService) FindSummaryByID(viewerID string, roomID string, grant string) (entities.Room, error) {
+	room, err := service.roomRepository.FindSummaryByID(roomID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if err := service.authorizeAccess(room, viewerID, grant); err != nil {
+		return entities.Room{}, err
+	}
+
+	return service.present(room, viewerID), nil
+}
+
+// JoinRoom checks the passcode or the invite list of a private room and hands
+// out the grant that lets the user in from then on.
+func (service *roomService) JoinRoom(userID string, roomID string, joinDTO dtos.JoinRoomDTO) (dtos.RoomGrantDTO, error) {
+	room, err := service.roomRepository.FindSummaryByID(roomID)
+	if err != nil {
+		return dtos.RoomGrantDTO{}, err
+	}
+
+	if !room.Settings.IsPublic() && !room.IsMember(userID) {
+		switch room.Settings.Visibility {
+		case entities.RoomVisibilityPasscode:
+			if joinDTO.Passcode == "" || service.securityProvider.Verify(room.Settings.Passcode, joinDTO.Passcode) != nil {
+				return dtos.RoomGrantDTO{}, application.NewForbiddenError("código de acesso inválido.")
+			}
+		case entities.RoomVisibilityInviteOnly:
+			invited, err := service.isInvited(room, userID)
+			if err != nil {
+				return dtos.RoomGrantDTO{}, err
+			}
+
+			if !invited {
+				return dtos.RoomGrantDTO{}, application.NewForbiddenError("você não foi convidado para esta sala.")
+			}
+		}
+	}
+
+	expiresAt := time.Now().Add(service.config.AccessGrantTTL)
+
+	return dtos.RoomGrantDTO{
+		Grant:     service.signer.Sign(grantValue(roomID, userID), expiresAt),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (service *roomService) FindQuestions(viewerID string, roomID string, query dtos.QuestionQueryDTO, grant string) (dtos.QuestionPageDTO, error) {
+	room, err := service.roomRepository.FindSummaryByID(roomID)
+	if err != nil {
+		return dtos.QuestionPageDTO{}, err
+	}
+
+	if err := service.authorizeAccess(room, viewerID, grant); err != nil {
+		return dtos.QuestionPageDTO{}, err
+	}
+
+	filter := questionFilter(query, repositories.QuestionOrderNewest)
+	filter.Status = entities.QuestionStatusApproved
+
+	page, err := service.roomRepository.FindQuestions(roomID, filter)
+	if err != nil {
+		return dtos.QuestionPageDTO{}, err
+	}
+
+	questions := service.presentQuestions(page.Questions, viewerID, room)
+
+	return dtos.QuestionPageDTO{
+		Questions:  questions,
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+// FindPendingQuestions lists the moderation queue, oldest questions first
+// unless another order is asked for.
+func (service *roomService) FindPendingQuestions(userID string, roomID string, query dtos.QuestionQueryDTO) (dtos.QuestionPageDTO, error) {
+	room, err := service.roomRepository.FindSummaryByID(roomID)
+	if err != nil {
+		return dtos.QuestionPageDTO{}, err
+	}
+
+	if err := service.roomPolicy.Authorize(room, userID, policies.PermissionReviewQuestion); err != nil {
+		return dtos.QuestionPageDTO{}, err
+	}
+
+	filter := questionFilter(query, repositories.QuestionOrderOldest)
+	filter.Status = entities.QuestionStatusPending
+
+	page, err := service.roomRepository.FindQuestions(roomID, filter)
+	if err != nil {
+		return dtos.QuestionPageDTO{}, err
+	}
+
+	return dtos.QuestionPageDTO{
+		Questions:  page.Questions,
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+// FindDeletedQuestions is the audit view of the room, listing the tombstones
+// of the deleted questions, most recent first.
+func (service *roomService) FindDeletedQuestions(userID string, roomID string, query dtos.QuestionQueryDTO) (dtos.QuestionPageDTO, error) {
+	room, err := service.roomRepository.FindSummaryByID(roomID)
+	if err != nil {
+		return dtos.QuestionPageDTO{}, err
+	}
+
+	if err := service.roomPolicy.Authorize(room, userID, policies.PermissionAuditQuestions); err != nil {
+		return dtos.QuestionPageDTO{}, err
+	}
+
+	filter := questionFilter(query, repositories.QuestionOrderNewest)
+	filter.Deleted = true
+
+	page, err := service.roomRepository.FindQuestions(roomID, filter)
+	if err != nil {
+		return dtos.QuestionPageDTO{}, err
+	}
+
+	return dtos.QuestionPageDTO{
+		Questions:  page.Questions,
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+func questionFilter(query dtos.QuestionQueryDTO, defaultOrder string) repositories.QuestionFilter {
+	filter := repositories.QuestionFilter{
+		Order:         query.Order,
+		IsAnswered:    query.IsAnswered,
+		IsHighlighted: query.IsHighlighted,
+		Cursor:        query.Cursor,
+		Limit:         query.Limit,
+	}
+
+	if filter.Order == "" {
+		filter.Order = defaultOrder
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = defaultPageSize
+	}
+
+	return filter
+}
+
+func (service *roomService) EndRoom(userID string, roomID string) (entities.Room, error) {
+	room, err := service.updateRoom(roomID, func(room *entities.Room) error {
+		if err := service.roomPolicy.Authorize(*room, userID, policies.PermissionEndRoom); err != nil {
+			return err
+		}
+
+		now := time.Now()
+
+		if err := room.EnsureNotEnded(now); err != nil {
+			return err
+		}
+
+		room.EndedAt = &now
+
+		return nil
+	})
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	service.publish(dtos.RoomEventDTO{Type: dtos.RoomEndedEvent, RoomID: roomID, Data: service.audienceView(room)})
+
+	return service.present(room, userID), nil
+}
+
+// ReopenRoom takes an ended room back to open. A scheduled end that already
+// passed is dropped, or the room would be ended again right away.
+func (service *roomService) ReopenRoom(userID string, roomID string) (entities.Room, error) {
+	room, err := service.updateRoom(roomID, func(room *entities.Room) error {
+		if err := service.roomPolicy.Authorize(*room, userID, policies.PermissionReopenRoom); err != nil {
+			return err
+		}
+
+		now := time.Now()
+
+		if !room.IsEnded(now) {
+			return domain.NewConflictError("a sala não está encerrada.")
+		}
+
+		room.EndedAt = nil
+
+		if room.ScheduledEndAt != nil && !now.Before(*room.ScheduledEndAt) {
+			room.ScheduledEndAt = nil
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	service.publish(dtos.RoomEventDTO{Type: dtos.RoomReopenedEvent, RoomID: roomID, Data: service.audienceView(room)})
+
+	return service.present(room, userID), nil
+}
+
+// EndDueRooms ends, as their owners would, the rooms whose scheduled end has
+// passed. A room that fails is left for the next run and does not hold back
+// the others.
+func (service *roomService) EndDueRooms() error {
+	rooms, err := service.roomRepository.FindDueRooms(time.Now())
+	if err != nil {
+		return err
+	}
+
+	var firstError error
+
+	for _, due := range rooms {
+		room, err := service.updateRoom(due.ID, func(room *entities.Room) error {
+			if room.EndedAt == nil {
+				room.EndedAt = room.ScheduledEndAt
+			}
+
+			return nil
+		})
+		if err != nil {
+			if firstError == nil {
+				firstError = err
+			}
+			continue
+		}
+
+		service.publish(dtos.RoomEventDTO{Type: dtos.RoomEndedEvent, RoomID: room.ID, Data: service.audienceView(room)})
+	}
+
+	return firstError
+}
+
+func (service *roomService) UpdateSettings(userID string, roomID string, settingsDTO dtos.RoomSettingsDTO) (entities.Room, error) {
+	// Hashing is slow, so it is done once instead of on every attempt.
+	var passcode string
+	if settingsDTO.Passcode != nil {
+		hash, err := service.securityProvider.Hash(*settingsDTO.Passcode)
+		if err != nil {
+			return entities.Room{}, err
+		}
+
+		passcode = hash
+	}
+
+	room, err := service.updateRoom(roomID, func(room *entities.Room) error {
+		if err := service.roomPolicy.Authorize(*room, userID, policies.PermissionManageSettings); err != nil {
+			return err
+		}
+
+		if settingsDTO.AllowAnonymousQuestions != nil {
+			room.Settings.AllowAnonymousQuestions = *settingsDTO.AllowAnonymousQuestions
+		}
 
-	return service.roomRepository.Update(roomID, room)
+		if settingsDTO.RequireApproval != nil {
+			room.Settings.RequireApproval = *settingsDTO.RequireApproval
+		}
+
+		if settingsDTO.Visibility != nil {
+			room.Settings.Visibility = *settingsDTO.Visibility
+		}
+
+		if passcode != "" {
+			room.Settings.Passcode = passcode
+		}
+
+		if settingsDTO.InvitedUserIDs != nil {
+			room.Settings.InvitedUserIDs = *settingsDTO.InvitedUserIDs
+		}
+
+		if settingsDTO.InvitedDomains != nil {
+			room.Settings.InvitedDomains = []string{}
+			for _, domain := range *settingsDTO.InvitedDomains {
+				room.Settings.InvitedDomains = append(room.Settings.InvitedDomains, strings.ToLower(domain))
+			}
+		}
+
+		if settingsDTO.MaxQuestionsPerUser != nil {
+			room.Settings.MaxQuestionsPerUser = *settingsDTO.MaxQuestionsPerUser
+		}
+
+		if settingsDTO.MinSecondsBetweenQuestions != nil {
+			room.Settings.MinSecondsBetweenQuestions = *settingsDTO.MinSecondsBetweenQuestions
+		}
+
+		if settingsDTO.MaxOpenQuestionsPerUser != nil {
+			room.Settings.MaxOpenQuestionsPerUser = *settingsDTO.MaxOpenQuestionsPerUser
+		}
+
+		if room.Settings.Visibility == entities.RoomVisibilityPasscode && room.Settings.Passcode == "" {
+			return domain.NewBadRequestError("defina um código de acesso para a sala.")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	service.publish(dtos.RoomEventDTO{Type: dtos.RoomSettingsUpdatedEvent, RoomID: roomID, Data: room.Settings.WithoutInvites()})
+
+	return service.present(room, userID), nil
 }
 
-func (service *roomService) CreateQuestion(roomID string, question entities.Question) (entities.Room, error) {
+func (service *roomService) CreateQuestion(userID string, roomID string, questionDTO dtos.QuestionDTO, grant string) (entities.Room, error) {
+	var question entities.Question
+	var err error
+
+	// The limits are checked against the room as it was read and once more by
+	// the push itself, so two questions sent at once cannot both get past
+	// them. A refused push reads the room again to tell which limit was hit.
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		var limited bool
+
+		question, limited, err = service.newQuestion(userID, roomID, questionDTO, grant)
+		if err != nil {
+			return entities.Room{}, err
+		}
+
+		question, err = service.roomRepository.PushQuestion(roomID, question, limited)
+		if err == nil {
+			break
+		}
+
+		var conflictError *domain.ConflictError
+		if !errors.As(err, &conflictError) {
+			return entities.Room{}, err
+		}
+	}
+
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if question.IsPublished() {
+		service.publish(dtos.RoomEventDTO{Type: dtos.QuestionCreatedEvent, RoomID: roomID, Data: question.Anonymized()})
+	}
+
 	room, err := service.roomRepository.FindByID(roomID)
 	if err != nil {
 		return entities.Room{}, err
 	}
 
-	room.AddQuestion(question)
+	return service.present(room, userID), nil
+}
+
+// newQuestion checks whether the user may ask the question in the room as it
+// is now and builds it, telling as well whether the limits of the room apply.
+func (service *roomService) newQuestion(userID string, roomID string, questionDTO dtos.QuestionDTO, grant string) (entities.Question, bool, error) {
+	room, err := service.roomRepository.FindByID(roomID)
+	if err != nil {
+		return entities.Question{}, false, err
+	}
+
+	if err := service.authorizeAccess(room, userID, grant); err != nil {
+		return entities.Question{}, false, err
+	}
+
+	if err := service.roomPolicy.Authorize(room, userID, policies.PermissionAskQuestion); err != nil {
+		return entities.Question{}, false, err
+	}
+
+	if err := room.AcceptsQuestions(time.Now()); err != nil {
+		return entities.Question{}, false, err
+	}
+
+	if questionDTO.IsAnonymous && !room.Settings.AllowAnonymousQuestions {
+		return entities.Question{}, false, application.NewForbiddenError("esta sala não aceita perguntas anônimas.")
+	}
+
+	// The limits are there to stop attendees from flooding the room, so those
+	// who moderate it are not held by them.
+	isModerator := service.roomPolicy.Authorize(room, userID, policies.PermissionReviewQuestion) == nil
+	if !isModerator {
+		if err := room.CheckQuestionLimits(userID, time.Now()); err != nil {
+			return entities.Question{}, false, err
+		}
+	}
+
+	user, err := service.userRepository.FindByID(userID)
+	if err != nil {
+		return entities.Question{}, false, err
+	}
+
+	question := entities.Question{
+		Content:     questionDTO.Content,
+		IsAnonymous: questionDTO.IsAnonymous,
+		Status:      entities.QuestionStatusApproved,
+		Author:      user.ToAuthor(),
+		CreatedAt:   time.Now(),
+	}
+
+	// Those who moderate the room do not need their own questions approved.
+	if room.Settings.RequireApproval && !isModerator {
+		question.Status = entities.QuestionStatusPending
+	}
 
-	return service.roomRepository.Update(roomID, room)
+	return question, !isModerator, nil
 }
 
 func (service *roomService) UpdateQuestion(userID string, roomID string, questionID string, questionData dtos.UpdateQuestionDTO) (entities.Room, error) {
@@ -71,60 +605,852 @@ func (service *roomService) UpdateQuestion(userID string, roomID string, questio
 		return entities.Room{}, err
 	}
 
-	if userID != room.Author.ID {
-		return entities.Room{}, application.NewForbiddenError("você não pode atualizar informações de uma sala que não é sua.")
+	if err := service.roomPolicy.Authorize(room, userID, policies.PermissionUpdateQuestion); err != nil {
+		return entities.Room{}, err
+	}
+
+	now := time.Now()
+	if err := room.EnsureNotEnded(now); err != nil {
+		// The owner gets a little while after the end to mark what was answered.
+		isWrappingUp := questionData.IsAnswered != nil && questionData.IsHighlighted == nil &&
+			room.RoleOf(userID) == entities.RoomRoleOwner && room.EndedWithin(service.config.AnswerGracePeriod, now)
+
+		if !isWrappingUp {
+			return entities.Room{}, err
+		}
+	}
+
+	question, err := room.FindQuestion(questionID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	// Highlighting or answering is announced to the whole audience, which
+	// must not learn about questions still waiting for moderation.
+	if !question.IsPublished() {
+		return entities.Room{}, domain.NewConflictError("somente perguntas publicadas podem ser destacadas ou respondidas.")
 	}
 
 	if questionData.IsAnswered != nil {
-		room.MarkQuestionAsAnswered(questionID)
+		var answerID string
+
+		if questionData.AnswerID != nil {
+			reply, err := question.FindReply(*questionData.AnswerID)
+			if err != nil {
+				return entities.Room{}, err
+			}
+
+			if !reply.IsOfficial {
+				return entities.Room{}, domain.NewBadRequestError("somente uma resposta oficial pode ser vinculada à pergunta.")
+			}
+
+			answerID = reply.ID
+		}
+
+		room, err = service.roomRepository.MarkQuestionAsAnswered(roomID, questionID, answerID)
+		if err != nil {
+			return entities.Room{}, err
+		}
+
+		service.publishQuestion(dtos.QuestionAnsweredEvent, room, questionID)
 	}
 
 	if questionData.IsHighlighted != nil {
-		room.UpdateQuestionHighlight(questionID, *questionData.IsHighlighted)
+		room, err = service.roomRepository.SetQuestionHighlight(roomID, questionID, *questionData.IsHighlighted)
+		if err != nil {
+			return entities.Room{}, err
+		}
+
+		service.publishQuestion(dtos.QuestionHighlightedEvent, room, questionID)
 	}
 
-	return service.roomRepository.Update(roomID, room)
+	return service.present(room, userID), nil
 }
 
-func (service *roomService) LikeQuestion(roomID string, questionID string, like entities.Like) (entities.Room, error) {
-	room, err := service.roomRepository.FindByID(roomID)
+// EditQuestion lets authors correct their questions until they are answered
+// or the edit window is over. The replaced content is kept as a revision.
+func (service *roomService) EditQuestion(userID string, roomID string, questionID string, questionDTO dtos.EditQuestionDTO) (entities.Room, error) {
+	var edited entities.Question
+
+	room, err := service.updateRoom(roomID, func(room *entities.Room) error {
+		if err := room.EnsureNotEnded(time.Now()); err != nil {
+			return err
+		}
+
+		for index := range room.Questions {
+			question := &room.Questions[index]
+			if question.ID != questionID || question.IsDeleted() {
+				continue
+			}
+
+			if userID != question.Author.ID {
+				return application.NewForbiddenError("você não pode editar uma pergunta que não é sua.")
+			}
+
+			if question.IsAnswered {
+				return domain.NewConflictError("uma pergunta já respondida não pode ser editada.")
+			}
+
+			if service.config.QuestionEditWindow > 0 && time.Since(question.CreatedAt) > service.config.QuestionEditWindow {
+				return application.NewForbiddenError("o prazo para editar esta pergunta já terminou.")
+			}
+
+			writtenAt := question.CreatedAt
+			if question.EditedAt != nil {
+				writtenAt = *question.EditedAt
+			}
+
+			now := time.Now()
+
+			question.Revisions = append(question.Revisions, entities.QuestionRevision{
+				Content:   question.Content,
+				CreatedAt: writtenAt,
+			})
+			question.Content = questionDTO.Content
+			question.EditedAt = &now
+
+			edited = *question
+
+			return nil
+		}
+
+		return domain.NewResourceNotFoundError("pergunta não encontrada.")
+	})
 	if err != nil {
 		return entities.Room{}, err
 	}
 
-	err = room.LikeQuestion(questionID, like)
+	if edited.IsPublished() {
+		service.publishQuestion(dtos.QuestionEditedEvent, room, questionID)
+	}
+
+	return service.present(room, userID), nil
+}
+
+func (service *roomService) FindQuestionRevisions(userID string, roomID string, questionID string) ([]entities.QuestionRevision, error) {
+	room, err := service.roomRepository.FindByID(roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := service.roomPolicy.Authorize(room, userID, policies.PermissionSeeRevisions); err != nil {
+		return nil, err
+	}
+
+	question, err := room.FindQuestion(questionID)
 	if err != nil {
-		return entities.Room{}, err
+		return nil, err
 	}
 
-	return service.roomRepository.Update(roomID, room)
+	if question.Revisions == nil {
+		return []entities.QuestionRevision{}, nil
+	}
+
+	return question.Revisions, nil
 }
 
-func (service *roomService) DeslikeQuestion(roomID string, questionID string, likeID string) (entities.Room, error) {
+func (service *roomService) LikeQuestion(userID string, roomID string, questionID string, grant string) (entities.Room, error) {
 	room, err := service.roomRepository.FindByID(roomID)
 	if err != nil {
 		return entities.Room{}, err
 	}
 
-	err = room.DeslikeQuestion(questionID, likeID)
+	if err := service.authorizeAccess(room, userID, grant); err != nil {
+		return entities.Room{}, err
+	}
+
+	if err := service.roomPolicy.Authorize(room, userID, policies.PermissionLikeQuestion); err != nil {
+		return entities.Room{}, err
+	}
+
+	if err := room.EnsureNotEnded(time.Now()); err != nil {
+		return entities.Room{}, err
+	}
+
+	question, err := room.FindQuestion(questionID)
 	if err != nil {
 		return entities.Room{}, err
 	}
 
-	return service.roomRepository.Update(roomID, room)
-}
+	if !question.IsPublished() {
+		return entities.Room{}, domain.NewResourceNotFoundError("pergunta não encontrada.")
+	}
 
-func (service *roomService) DeleteQuestion(userID string, roomID string, questionID string) (entities.Room, error) {
-	room, err := service.roomRepository.FindByID(roomID)
+	user, err := service.userRepository.FindByID(userID)
 	if err != nil {
 		return entities.Room{}, err
 	}
 
-	if userID != room.Author.ID {
-		return entities.Room{}, application.NewForbiddenError("você não pode remover uma pergunta de uma sala que não é sua.")
+	like := entities.Like{
+		Author:    user.ToAuthor(),
+		CreatedAt: time.Now(),
+	}
+
+	room, err = service.roomRepository.AddLike(roomID, questionID, like)
+	if err != nil {
+		return entities.Room{}, err
 	}
 
-	room.DeleteQuestion(questionID)
+	service.publishQuestion(dtos.QuestionLikedEvent, room, questionID)
+
+	return service.present(room, userID), nil
+}
+
+func (service *roomService) DeslikeQuestion(userID string, roomID string, questionID string, likeID string) (entities.Room, error) {
+	room, err := service.roomRepository.FindByID(roomID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if err := room.EnsureNotEnded(time.Now()); err != nil {
+		return entities.Room{}, err
+	}
+
+	question, err := room.FindQuestion(questionID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	like, err := question.FindLike(likeID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if userID != like.Author.ID {
+		if err := service.roomPolicy.Authorize(room, userID, policies.PermissionRemoveAnyLike); err != nil {
+			return entities.Room{}, err
+		}
+	}
+
+	room, err = service.roomRepository.RemoveLike(roomID, questionID, likeID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	service.publishQuestion(dtos.QuestionUnlikedEvent, room, questionID)
+
+	return service.present(room, userID), nil
+}
+
+// DeleteQuestion takes the question down but keeps a tombstone of it, which
+// only shows up in the audit of the room.
+func (service *roomService) DeleteQuestion(userID string, roomID string, questionID string) (entities.Room, error) {
+	room, err := service.roomRepository.FindByID(roomID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	question, err := room.FindQuestion(questionID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	reason, err := service.roomPolicy.CanDeleteQuestion(room, userID, question)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if err := room.EnsureNotEnded(time.Now()); err != nil {
+		return entities.Room{}, err
+	}
+
+	deletion := entities.QuestionDeletion{
+		Reason:    reason,
+		DeletedBy: userID,
+		DeletedAt: time.Now(),
+	}
+
+	room, err = service.roomRepository.MarkQuestionAsDeleted(roomID, questionID, deletion)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	service.publish(dtos.RoomEventDTO{Type: dtos.QuestionDeletedEvent, RoomID: roomID, Data: map[string]string{"id": questionID}})
+
+	return service.present(room, userID), nil
+}
+
+func (service *roomService) ApproveQuestion(userID string, roomID string, questionID string) (entities.Room, error) {
+	room, err := service.reviewQuestion(userID, roomID, questionID, entities.QuestionStatusApproved)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	service.publishQuestion(dtos.QuestionApprovedEvent, room, questionID)
+
+	return service.present(room, userID), nil
+}
+
+func (service *roomService) RejectQuestion(userID string, roomID string, questionID string) (entities.Room, error) {
+	room, err := service.reviewQuestion(userID, roomID, questionID, entities.QuestionStatusRejected)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	return service.present(room, userID), nil
+}
+
+func (service *roomService) reviewQuestion(userID string, roomID string, questionID string, status string) (entities.Room, error) {
+	room, err := service.roomRepository.FindByID(roomID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if err := service.roomPolicy.Authorize(room, userID, policies.PermissionReviewQuestion); err != nil {
+		return entities.Room{}, err
+	}
+
+	if err := room.EnsureNotEnded(time.Now()); err != nil {
+		return entities.Room{}, err
+	}
+
+	question, err := room.FindQuestion(questionID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if question.Status != entities.QuestionStatusPending {
+		return entities.Room{}, domain.NewConflictError("a pergunta não está aguardando moderação.")
+	}
+
+	return service.roomRepository.SetQuestionStatus(roomID, questionID, status)
+}
+
+func (service *roomService) CreateReply(userID string, roomID string, questionID string, replyDTO dtos.ReplyDTO, grant string) (entities.Room, error) {
+	room, err := service.roomRepository.FindByID(roomID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if err := service.authorizeAccess(room, userID, grant); err != nil {
+		return entities.Room{}, err
+	}
+
+	if err := service.roomPolicy.Authorize(room, userID, policies.PermissionReply); err != nil {
+		return entities.Room{}, err
+	}
+
+	if replyDTO.IsOfficial {
+		if err := service.roomPolicy.Authorize(room, userID, policies.PermissionOfficialAnswer); err != nil {
+			return entities.Room{}, err
+		}
+	}
+
+	if err := room.EnsureNotEnded(time.Now()); err != nil {
+		return entities.Room{}, err
+	}
+
+	question, err := room.FindQuestion(questionID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if !question.IsPublished() {
+		return entities.Room{}, domain.NewResourceNotFoundError("pergunta não encontrada.")
+	}
+
+	user, err := service.userRepository.FindByID(userID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	reply := entities.Reply{
+		Content:    replyDTO.Content,
+		Author:     user.ToAuthor(),
+		IsOfficial: replyDTO.IsOfficial,
+		CreatedAt:  time.Now(),
+	}
+
+	room, err = service.roomRepository.AddReply(roomID, questionID, reply)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	service.publishQuestion(dtos.ReplyCreatedEvent, room, questionID)
+
+	return service.present(room, userID), nil
+}
+
+// UpdateReply lets authors edit what they wrote, while the official marker is
+// up to those who run the room.
+func (service *roomService) UpdateReply(userID string, roomID string, questionID string, replyID string, replyData dtos.UpdateReplyDTO) (entities.Room, error) {
+	room, err := service.roomRepository.FindByID(roomID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if err := room.EnsureNotEnded(time.Now()); err != nil {
+		return entities.Room{}, err
+	}
+
+	question, err := room.FindQuestion(questionID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	reply, err := question.FindReply(replyID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if replyData.Content != nil {
+		if userID != reply.Author.ID {
+			return entities.Room{}, application.NewForbiddenError("você não pode editar uma resposta que não é sua.")
+		}
+
+		reply.Content = *replyData.Content
+	}
+
+	if replyData.IsOfficial != nil {
+		if err := service.roomPolicy.Authorize(room, userID, policies.PermissionOfficialAnswer); err != nil {
+			return entities.Room{}, err
+		}
+
+		if !*replyData.IsOfficial && question.AnswerID == reply.ID {
+			return entities.Room{}, domain.NewBadRequestError("a resposta vinculada à pergunta não pode deixar de ser oficial.")
+		}
+
+		reply.IsOfficial = *replyData.IsOfficial
+	}
+
+	now := time.Now()
+	reply.UpdatedAt = &now
+
+	room, err = service.roomRepository.UpdateReply(roomID, questionID, reply)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	service.publishQuestion(dtos.ReplyUpdatedEvent, room, questionID)
+
+	return service.present(room, userID), nil
+}
+
+func (service *roomService) DeleteReply(userID string, roomID string, questionID string, replyID string) (entities.Room, error) {
+	room, err := service.roomRepository.FindByID(roomID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if err := room.EnsureNotEnded(time.Now()); err != nil {
+		return entities.Room{}, err
+	}
+
+	question, err := room.FindQuestion(questionID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	reply, err := question.FindReply(replyID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if userID != reply.Author.ID {
+		if err := service.roomPolicy.Authorize(room, userID, policies.PermissionDeleteAnyReply); err != nil {
+			return entities.Room{}, err
+		}
+	}
+
+	room, err = service.roomRepository.RemoveReply(roomID, questionID, replyID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	service.publishQuestion(dtos.ReplyDeletedEvent, room, questionID)
+
+	return service.present(room, userID), nil
+}
+
+// CreatePoll adds the poll as a draft, so the audience only gets to see it
+// once it is opened.
+func (service *roomService) CreatePoll(userID string, roomID string, pollDTO dtos.PollDTO) (entities.Room, error) {
+	room, err := service.roomRepository.FindSummaryByID(roomID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if err := service.roomPolicy.Authorize(room, userID, policies.PermissionManagePolls); err != nil {
+		return entities.Room{}, err
+	}
+
+	if err := room.EnsureNotEnded(time.Now()); err != nil {
+		return entities.Room{}, err
+	}
+
+	poll := entities.Poll{
+		Question:       pollDTO.Question,
+		MultipleChoice: pollDTO.MultipleChoice,
+		Status:         entities.PollStatusDraft,
+		CreatedAt:      time.Now(),
+	}
+
+	for _, option := range pollDTO.Options {
+		poll.Options = append(poll.Options, entities.PollOption{Text: option})
+	}
+
+	room, err = service.roomRepository.PushPoll(roomID, poll)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	return service.present(room, userID), nil
+}
+
+func (service *roomService) OpenPoll(userID string, roomID string, pollID string) (entities.Room, error) {
+	room, err := service.setPollStatus(userID, roomID, pollID, entities.PollStatusOpen)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	service.publishPoll(dtos.PollOpenedEvent, room, pollID)
+
+	return service.present(room, userID), nil
+}
+
+func (service *roomService) ClosePoll(userID string, roomID string, pollID string) (entities.Room, error) {
+	room, err := service.setPollStatus(userID, roomID, pollID, entities.PollStatusClosed)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	service.publishPoll(dtos.PollClosedEvent, room, pollID)
+
+	return service.present(room, userID), nil
+}
+
+// setPollStatus moves a poll to the given status. Closed polls can be opened
+// again, but drafts can not be closed. Polls left open when the room ended can
+// still be closed.
+func (service *roomService) setPollStatus(userID string, roomID string, pollID string, status string) (entities.Room, error) {
+	room, err := service.roomRepository.FindSummaryByID(roomID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if err := service.roomPolicy.Authorize(room, userID, policies.PermissionManagePolls); err != nil {
+		return entities.Room{}, err
+	}
+
+	poll, err := room.FindPoll(pollID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if status == entities.PollStatusOpen {
+		if err := room.EnsureNotEnded(time.Now()); err != nil {
+			return entities.Room{}, err
+		}
+	}
+
+	if status == entities.PollStatusOpen && poll.Status == entities.PollStatusOpen {
+		return entities.Room{}, domain.NewConflictError("a enquete já está aberta.")
+	}
+
+	if status == entities.PollStatusClosed && poll.Status != entities.PollStatusOpen {
+		return entities.Room{}, domain.NewConflictError("a enquete não está aberta.")
+	}
+
+	return service.roomRepository.SetPollStatus(roomID, pollID, status)
+}
+
+func (service *roomService) Vote(userID string, roomID string, pollID string, voteDTO dtos.VoteDTO, grant string) (entities.Room, error) {
+	room, err := service.roomRepository.FindSummaryByID(roomID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if err := service.authorizeAccess(room, userID, grant); err != nil {
+		return entities.Room{}, err
+	}
+
+	if err := service.roomPolicy.Authorize(room, userID, policies.PermissionVote); err != nil {
+		return entities.Room{}, err
+	}
+
+	if err := room.EnsureNotEnded(time.Now()); err != nil {
+		return entities.Room{}, err
+	}
+
+	poll, err := room.FindPoll(pollID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if poll.Status == entities.PollStatusDraft {
+		return entities.Room{}, domain.NewResourceNotFoundError("enquete não encontrada.")
+	}
+
+	if poll.Status != entities.PollStatusOpen {
+		return entities.Room{}, domain.NewConflictError("a enquete não está aberta para votação.")
+	}
+
+	if poll.HasVoted(userID) {
+		return entities.Room{}, domain.NewConflictError("você já votou nesta enquete.")
+	}
+
+	if !poll.MultipleChoice && len(voteDTO.OptionIDs) > 1 {
+		return entities.Room{}, domain.NewBadRequestError("esta enquete aceita somente uma opção.")
+	}
+
+	chosen := map[string]bool{}
+	for _, optionID := range voteDTO.OptionIDs {
+		if _, err := poll.FindOption(optionID); err != nil {
+			return entities.Room{}, err
+		}
+
+		if chosen[optionID] {
+			return entities.Room{}, domain.NewBadRequestError("a mesma opção não pode ser escolhida duas vezes.")
+		}
+
+		chosen[optionID] = true
+	}
+
+	vote := entities.PollVote{
+		UserID:    userID,
+		OptionIDs: voteDTO.OptionIDs,
+		CreatedAt: time.Now(),
+	}
+
+	room, err = service.roomRepository.AddVote(roomID, pollID, vote)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	service.publishPoll(dtos.PollVotedEvent, room, pollID)
+
+	return service.present(room, userID), nil
+}
+
+func (service *roomService) AddMember(userID string, roomID string, memberDTO dtos.MemberDTO) (entities.Room, error) {
+	user, err := service.userRepository.FindByID(memberDTO.UserID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	member := entities.Member{
+		User:    user.ToAuthor(),
+		Role:    memberDTO.Role,
+		AddedAt: time.Now(),
+	}
+
+	room, err := service.updateRoom(roomID, func(room *entities.Room) error {
+		if member.User.ID == room.Author.ID {
+			return domain.NewBadRequestError("o papel do dono da sala não pode ser alterado.")
+		}
+
+		// Demoting a co-host takes the same permission as promoting one.
+		if err := service.roomPolicy.CanManageMember(*room, userID, room.RoleOf(member.User.ID)); err != nil {
+			return err
+		}
+
+		if err := service.roomPolicy.CanManageMember(*room, userID, member.Role); err != nil {
+			return err
+		}
+
+		for index, current := range room.Members {
+			if current.User.ID == member.User.ID {
+				member.AddedAt = current.AddedAt
+				room.Members[index] = member
+				return nil
+			}
+		}
+
+		room.Members = append(room.Members, member)
+
+		return nil
+	})
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	service.publish(dtos.RoomEventDTO{Type: dtos.MemberAddedEvent, RoomID: roomID, Data: member})
+
+	return service.present(room, userID), nil
+}
+
+func (service *roomService) RemoveMember(userID string, roomID string, memberID string) (entities.Room, error) {
+	room, err := service.updateRoom(roomID, func(room *entities.Room) error {
+		if memberID == room.Author.ID {
+			return domain.NewBadRequestError("o dono não pode ser removido da sala.")
+		}
+
+		// Members are always free to leave a room on their own.
+		if userID != memberID {
+			if err := service.roomPolicy.CanManageMember(*room, userID, room.RoleOf(memberID)); err != nil {
+				return err
+			}
+		}
+
+		if _, err := room.FindMember(memberID); err != nil {
+			return err
+		}
+
+		var members []entities.Member
+		for _, current := range room.Members {
+			if current.User.ID != memberID {
+				members = append(members, current)
+			}
+		}
+
+		room.Members = members
+
+		return nil
+	})
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	service.publish(dtos.RoomEventDTO{Type: dtos.MemberRemovedEvent, RoomID: roomID, Data: map[string]string{"id": memberID}})
+
+	return service.present(room, userID), nil
+}
+
+// updateRoom runs a read-modify-write cycle over the whole room, starting
+// over from a fresh read whenever the write loses a race against another one.
+func (service *roomService) updateRoom(roomID string, modify func(room *entities.Room) error) (entities.Room, error) {
+	var err error
+
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		var room entities.Room
+
+		room, err = service.roomRepository.FindByID(roomID)
+		if err != nil {
+			return entities.Room{}, err
+		}
+
+		if err := modify(&room); err != nil {
+			return entities.Room{}, err
+		}
+
+		room, err = service.roomRepository.Update(roomID, room)
+		if err == nil {
+			return room, nil
+		}
+
+		var conflictError *domain.ConflictError
+		if !errors.As(err, &conflictError) {
+			return entities.Room{}, err
+		}
+	}
+
+	return entities.Room{}, err
+}
+
+func (service *roomService) publishQuestion(eventType string, room entities.Room, questionID string) {
+	question, err := room.FindQuestion(questionID)
+	if err != nil {
+		return
+	}
+
+	service.publish(dtos.RoomEventDTO{Type: eventType, RoomID: room.ID, Data: question.Anonymized()})
+}
+
+func (service *roomService) publishPoll(eventType string, room entities.Room, pollID string) {
+	poll, err := room.FindPoll(pollID)
+	if err != nil {
+		return
+	}
+
+	service.publish(dtos.RoomEventDTO{Type: eventType, RoomID: room.ID, Data: poll})
+}
+
+// present hides the authors of anonymous questions, along with the questions
+// that were not approved, from anyone who does not moderate the room. Draft
+// polls are only shown to those who run the polls, and deleted questions are
+// left out for everyone.
+func (service *roomService) present(room entities.Room, viewerID string) entities.Room {
+	room = room.Settled(time.Now())
+	room = room.Visible()
+
+	if err := service.roomPolicy.Authorize(room, viewerID, policies.PermissionSeeAnonymous); err != nil {
+		room = room.Anonymized()
+	}
+
+	if err := service.roomPolicy.Authorize(room, viewerID, policies.PermissionReviewQuestion); err != nil {
+		room = room.Published()
+	}
+
+	if err := service.roomPolicy.Authorize(room, viewerID, policies.PermissionManagePolls); err != nil {
+		room = room.Launched()
+	}
+
+	if err := service.roomPolicy.Authorize(room, viewerID, policies.PermissionManageSettings); err != nil {
+		room.Settings = room.Settings.WithoutInvites()
+	}
+
+	return room
+}
+
+// audienceView is the room as someone who only takes part in it sees it,
+// which is all that events sent to everyone watching the room may carry.
+func (service *roomService) audienceView(room entities.Room) entities.Room {
+	return service.present(room, "")
+}
+
+// presentQuestions does the same as present for a page of questions of the
+// room, which is loaded without its questions.
+func (service *roomService) presentQuestions(questions []entities.Question, viewerID string, room entities.Room) []entities.Question {
+	if err := service.roomPolicy.Authorize(room, viewerID, policies.PermissionSeeAnonymous); err == nil {
+		return questions
+	}
+
+	anonymized := make([]entities.Question, len(questions))
+	for index, question := range questions {
+		anonymized[index] = question.Anonymized()
+	}
+
+	return anonymized
+}
+
+// authorizeAccess lets everyone into public rooms, as well as those who were
+// added to the room. Anyone else needs a grant from JoinRoom, except for the
+// invited users, who are let in straight away.
+func (service *roomService) authorizeAccess(room entities.Room, userID string, grant string) error {
+	if room.Settings.IsPublic() || room.IsMember(userID) {
+		return nil
+	}
+
+	if grant != "" {
+		value, err := service.signer.Verify(grant)
+		if err == nil && value == grantValue(room.ID, userID) {
+			return nil
+		}
+	}
+
+	if room.Settings.Visibility == entities.RoomVisibilityInviteOnly {
+		invited, err := service.isInvited(room, userID)
+		if err != nil {
+			return err
+		}
+
+		if invited {
+			return nil
+		}
+	}
+
+	return application.NewForbiddenError("esta sala é privada.")
+}
+
+func (service *roomService) isInvited(room entities.Room, userID string) (bool, error) {
+	if userID == "" {
+		return false, nil
+	}
+
+	user, err := service.userRepository.FindByID(userID)
+	if err != nil {
+		return false, err
+	}
+
+	return room.Settings.Invites(user), nil
+}
+
+// grantValue ties a grant to both the room and the user it was given to.
+func grantValue(roomID string, userID string) string {
+	return "room:" + roomID + ":" + userID
+}
 
-	return service.roomRepository.Update(roomID, room)
+func (service *roomService) publish(event dtos.RoomEventDTO) {
+	event.CreatedAt = time.Now()
+	service.broadcaster.Publish(event)
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"io/ioutil"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
@@ -11,23 +12,30 @@ import (
 
 	"github.com/waliqueiroz/letmeask-api/internal/application/dtos"
 	application "github.com/waliqueiroz/letmeask-api/internal/application/errors"
+	"github.com/waliqueiroz/letmeask-api/internal/application/policies"
 	"github.com/waliqueiroz/letmeask-api/internal/application/services"
 	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
 	domain "github.com/waliqueiroz/letmeask-api/internal/domain/errors"
+	"github.com/waliqueiroz/letmeask-api/internal/domain/repositories"
+	broadcastingMocks "github.com/waliqueiroz/letmeask-api/internal/infrastructure/broadcasting/mocks"
 	repositoriesMocks "github.com/waliqueiroz/letmeask-api/internal/infrastructure/database/mongodb/repositories/mocks"
+	securityMocks "github.com/waliqueiroz/letmeask-api/internal/infrastructure/security/mocks"
 )
 
 var _ = Describe("Room", func() {
+	roomPolicy := policies.NewRoomPolicy(true)
+	roomConfig := services.RoomConfig{QuestionEditWindow: 5 * time.Minute, AnswerGracePeriod: 15 * time.Minute}
 
 	Describe("Executing the Create function", func() {
-		var room entities.Room
+		var userID string
+		var roomDTO dtos.RoomDTO
 		var result entities.Room
 		var createError error
 		var roomService services.RoomService
 		var mockCtrl *gomock.Controller
 
 		JustBeforeEach(func() {
-			result, createError = roomService.Create(room)
+			result, createError = roomService.Create(userID, roomDTO)
 		})
 
 		When("the Create function is executed with success", func() {
@@ -40,26 +48,5321 @@ var _ = Describe("Room", func() {
 				createdRoomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				err = json.Unmarshal(createRoomRequestSerialized, &room)
+				userSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(createRoomRequestSerialized, &roomDTO)
 				Expect(err).NotTo(HaveOccurred())
 
 				err = json.Unmarshal(createdRoomSerialized, &expectedCreateResult)
 				Expect(err).NotTo(HaveOccurred())
 
+				var user entities.User
+				err = json.Unmarshal(userSerialized, &user)
+				Expect(err).NotTo(HaveOccurred())
+
+				verifiedAt := time.Now()
+				user.VerifiedAt = &verifiedAt
+				userID = user.ID
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().Create(gomock.AssignableToTypeOf(entities.Room{})).Do(func(room entities.Room) {
+					Expect(room.Title).To(Equal(roomDTO.Title))
+					Expect(room.Author).To(Equal(user.ToAuthor()))
+					Expect(room.Members).To(HaveLen(1))
+					Expect(room.Members[0].User).To(Equal(user.ToAuthor()))
+					Expect(room.Members[0].Role).To(Equal(entities.RoomRoleOwner))
+				}).Return(expectedCreateResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(user, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.Create result", func() {
+				Expect(result).To(Equal(expectedCreateResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(createError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user has not verified the email yet", func() {
+			BeforeEach(func() {
+				createRoomRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_room_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				userSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(createRoomRequestSerialized, &roomDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				var user entities.User
+				err = json.Unmarshal(userSerialized, &user)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = user.ID
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(user, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(createError).To(Equal(application.NewForbiddenError("confirme seu e-mail antes de criar uma sala.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while finding the user", func() {
+			BeforeEach(func() {
+				createRoomRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_room_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(createRoomRequestSerialized, &roomDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(entities.User{}, errors.New("an error")).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the error returned by the userRepository.FindByID function", func() {
+				Expect(createError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while saving room in database", func() {
+			BeforeEach(func() {
+				createRoomRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_room_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(createRoomRequestSerialized, &roomDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				verifiedAt := time.Now()
+				user := entities.User{ID: "6117e377b6e7bae09f52c483", Name: "Teste 1", VerifiedAt: &verifiedAt}
+				userID = user.ID
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().Create(gomock.AssignableToTypeOf(entities.Room{})).Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(user, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the error returned by the roomRepository.Create function", func() {
+				Expect(createError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the scheduled end is not after the scheduled start", func() {
+			BeforeEach(func() {
+				userSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var user entities.User
+				err = json.Unmarshal(userSerialized, &user)
+				Expect(err).NotTo(HaveOccurred())
+
+				verifiedAt := time.Now()
+				user.VerifiedAt = &verifiedAt
+				userID = user.ID
+
+				scheduledStartAt := time.Now().Add(2 * time.Hour)
+				scheduledEndAt := time.Now().Add(time.Hour)
+				roomDTO = dtos.RoomDTO{Title: "Dúvidas sobre Symbian", ScheduledStartAt: &scheduledStartAt, ScheduledEndAt: &scheduledEndAt}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(user, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a bad request error", func() {
+				Expect(createError).To(Equal(domain.NewBadRequestError("o encerramento agendado deve ser posterior à abertura.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the scheduled end has already passed", func() {
+			BeforeEach(func() {
+				userSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var user entities.User
+				err = json.Unmarshal(userSerialized, &user)
+				Expect(err).NotTo(HaveOccurred())
+
+				verifiedAt := time.Now()
+				user.VerifiedAt = &verifiedAt
+				userID = user.ID
+
+				scheduledEndAt := time.Now().Add(-time.Hour)
+				roomDTO = dtos.RoomDTO{Title: "Dúvidas sobre Symbian", ScheduledEndAt: &scheduledEndAt}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(user, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a bad request error", func() {
+				Expect(createError).To(Equal(domain.NewBadRequestError("o encerramento agendado deve estar no futuro.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the FindAll function", func() {
+		var viewerID string
+		var query dtos.RoomQueryDTO
+		var result dtos.RoomPageDTO
+		var findAllError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		BeforeEach(func() {
+			viewerID = ""
+		})
+
+		JustBeforeEach(func() {
+			result, findAllError = roomService.FindAll(viewerID, query)
+		})
+
+		When("the FindAll function is executed with the default query", func() {
+			var expectedRoom entities.Room
+
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomSerialized, &expectedRoom)
+				Expect(err).NotTo(HaveOccurred())
+
+				query = dtos.RoomQueryDTO{}
+
+				expectedFilter := repositories.RoomFilter{
+					Descending: true,
+					Limit:      20,
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindAll(expectedFilter).Return(repositories.RoomPage{
+					Rooms:      []entities.Room{expectedRoom},
+					NextCursor: "next",
+				}, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should contain the page returned by roomRepository.FindAll", func() {
+				Expect(result).To(Equal(dtos.RoomPageDTO{
+					Rooms:      []entities.Room{expectedRoom},
+					NextCursor: "next",
+				}))
+			})
+
+			It("error should be nil", func() {
+				Expect(findAllError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the FindAll function is executed with filters and sorting", func() {
+			BeforeEach(func() {
+				query = dtos.RoomQueryDTO{
+					AuthorID:    "621f5e02e07fdbb81c8221f5",
+					Status:      "ended",
+					Search:      "symbian",
+					CreatedFrom: "2022-03-01T00:00:00Z",
+					CreatedTo:   "2022-03-31T23:59:59Z",
+					Sort:        "title",
+					Cursor:      "cursor",
+					Limit:       5,
+				}
+
+				createdFrom := time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)
+				createdTo := time.Date(2022, time.March, 31, 23, 59, 59, 0, time.UTC)
+
+				expectedFilter := repositories.RoomFilter{
+					AuthorID:    "621f5e02e07fdbb81c8221f5",
+					Status:      "ended",
+					Search:      "symbian",
+					CreatedFrom: &createdFrom,
+					CreatedTo:   &createdTo,
+					SortBy:      "title",
+					Descending:  false,
+					Cursor:      "cursor",
+					Limit:       5,
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindAll(expectedFilter).Return(repositories.RoomPage{Rooms: []entities.Room{}}, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty page", func() {
+				Expect(result).To(Equal(dtos.RoomPageDTO{Rooms: []entities.Room{}}))
+			})
+
+			It("error should be nil", func() {
+				Expect(findAllError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while finding rooms", func() {
+			BeforeEach(func() {
+				query = dtos.RoomQueryDTO{}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindAll(gomock.AssignableToTypeOf(repositories.RoomFilter{})).Return(repositories.RoomPage{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty page", func() {
+				Expect(result).To(Equal(dtos.RoomPageDTO{}))
+			})
+
+			It("error should be the error returned by the roomRepository.FindAll function", func() {
+				Expect(findAllError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the listed rooms have members, invites and draft polls", func() {
+			var expectedRoom entities.Room
+
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_poll.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(roomSerialized, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				room.Polls[0].Status = entities.PollStatusDraft
+				room.Members = []entities.Member{
+					{User: entities.Author{ID: "621f5e02e07fdbb81c8221f5"}, Role: entities.RoomRoleOwner},
+				}
+				room.Settings = entities.RoomSettings{
+					Visibility:     entities.RoomVisibilityInviteOnly,
+					InvitedUserIDs: []string{"6117e377b6e7bae09f52c483"},
+					InvitedDomains: []string{"example.com"},
+				}
+
+				expectedRoom = room
+
+				viewerID = "6117e377b6e7bae09f52c483"
+				query = dtos.RoomQueryDTO{}
+
+				expectedFilter := repositories.RoomFilter{
+					ViewerID:   viewerID,
+					Descending: true,
+					Limit:      20,
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindAll(expectedFilter).Return(repositories.RoomPage{
+					Rooms: []entities.Room{room},
+				}, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("the members should be left out", func() {
+				Expect(result.Rooms[0].Members).To(BeNil())
+			})
+
+			It("the invites should be left out", func() {
+				Expect(result.Rooms[0].Settings.InvitedUserIDs).To(BeEmpty())
+				Expect(result.Rooms[0].Settings.InvitedDomains).To(BeEmpty())
+				Expect(result.Rooms[0].Settings.Visibility).To(Equal(expectedRoom.Settings.Visibility))
+			})
+
+			It("the draft polls should be left out", func() {
+				Expect(result.Rooms[0].Polls).To(BeEmpty())
+			})
+
+			It("error should be nil", func() {
+				Expect(findAllError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the FindByID function", func() {
+		var viewerID string
+		var roomID string
+		var result entities.Room
+		var findByIDError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, findByIDError = roomService.FindByID(viewerID, roomID, "")
+		})
+
+		When("the FindByID function is executed with success", func() {
+			var expectedFindByIDResult entities.Room
+
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.FindByID result", func() {
+				Expect(result).To(Equal(expectedFindByIDResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(findByIDError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an attendee reads a room with anonymous questions", func() {
+			var expectedFindByIDResult entities.Room
+
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_anonymous_question.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				viewerID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("the author of the anonymous question should be hidden", func() {
+				Expect(result.Questions[0].Author).To(Equal(entities.Author{}))
+			})
+
+			It("the rest of the question should be kept", func() {
+				Expect(result.Questions[0].Content).To(Equal(expectedFindByIDResult.Questions[0].Content))
+				Expect(result.Questions[0].IsAnonymous).To(BeTrue())
+			})
+
+			It("error should be nil", func() {
+				Expect(findByIDError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the room owner reads a room with anonymous questions", func() {
+			var expectedFindByIDResult entities.Room
+
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_anonymous_question.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				viewerID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.FindByID result", func() {
+				Expect(result).To(Equal(expectedFindByIDResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(findByIDError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an attendee reads a room with questions waiting for moderation", func() {
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_pending_question.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				viewerID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("the pending questions should be left out", func() {
+				Expect(result.Questions).To(BeEmpty())
+			})
+
+			It("error should be nil", func() {
+				Expect(findByIDError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while finding room by ID", func() {
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the error returned by the roomRepository.FindByID function", func() {
+				Expect(findByIDError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the FindByCode function", func() {
+		var viewerID string
+		var code string
+		var result entities.Room
+		var findByCodeError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, findByCodeError = roomService.FindByCode(viewerID, code, "")
+		})
+
+		When("the FindByCode function is executed with success", func() {
+			var expectedFindByCodeResult entities.Room
+
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomSerialized, &expectedFindByCodeResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				code = "K7QM2X"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByCode(code).Return(expectedFindByCodeResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.FindByCode result", func() {
+				Expect(result).To(Equal(expectedFindByCodeResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(findByCodeError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("no room has the given code", func() {
+			BeforeEach(func() {
+				code = "ZZZZZZ"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByCode(code).Return(entities.Room{}, domain.NewResourceNotFoundError("sala não encontrada")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a not found error", func() {
+				Expect(findByCodeError).To(Equal(domain.NewResourceNotFoundError("sala não encontrada")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the FindSummaryByID function", func() {
+		var viewerID string
+		var roomID string
+		var result entities.Room
+		var findSummaryByIDError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, findSummaryByIDError = roomService.FindSummaryByID(viewerID, roomID, "")
+		})
+
+		When("the FindSummaryByID function is executed with success", func() {
+			var expectedFindSummaryByIDResult entities.Room
+
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomSerialized, &expectedFindSummaryByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedFindSummaryByIDResult.QuestionCount = 3
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(expectedFindSummaryByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.FindSummaryByID result", func() {
+				Expect(result).To(Equal(expectedFindSummaryByIDResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(findSummaryByIDError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while finding the room summary", func() {
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the error returned by the roomRepository.FindSummaryByID function", func() {
+				Expect(findSummaryByIDError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the JoinRoom function", func() {
+		var userID string
+		var roomID string
+		var joinDTO dtos.JoinRoomDTO
+		var result dtos.RoomGrantDTO
+		var joinRoomError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, joinRoomError = roomService.JoinRoom(userID, roomID, joinDTO)
+		})
+
+		When("the user enters the right passcode", func() {
+			BeforeEach(func() {
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				joinDTO = dtos.JoinRoomDTO{Passcode: "1234"}
+
+				summary := entities.Room{
+					ID:       roomID,
+					Author:   entities.Author{ID: "621f5e02e07fdbb81c8221f5"},
+					Settings: entities.RoomSettings{Visibility: entities.RoomVisibilityPasscode, Passcode: "hashed"},
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(summary, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+				mockSecurityProvider.EXPECT().Verify("hashed", "1234").Return(nil).Times(1)
+
+				mockSigner := securityMocks.NewMockSigner(mockCtrl)
+				mockSigner.EXPECT().Sign("room:621f5ec1e07fdbb81c8221f7:6117e377b6e7bae09f52c483", gomock.AssignableToTypeOf(time.Time{})).Return("grant").Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, mockSecurityProvider, mockSigner, mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should carry the grant", func() {
+				Expect(result.Grant).To(Equal("grant"))
+			})
+
+			It("error should be nil", func() {
+				Expect(joinRoomError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user enters a wrong passcode", func() {
+			BeforeEach(func() {
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				joinDTO = dtos.JoinRoomDTO{Passcode: "4321"}
+
+				summary := entities.Room{
+					ID:       roomID,
+					Author:   entities.Author{ID: "621f5e02e07fdbb81c8221f5"},
+					Settings: entities.RoomSettings{Visibility: entities.RoomVisibilityPasscode, Passcode: "hashed"},
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(summary, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+				mockSecurityProvider.EXPECT().Verify("hashed", "4321").Return(errors.New("mismatch")).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, mockSecurityProvider, securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty grant", func() {
+				Expect(result).To(Equal(dtos.RoomGrantDTO{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(joinRoomError).To(Equal(application.NewForbiddenError("código de acesso inválido.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user is invited by the domain of the email", func() {
+			BeforeEach(func() {
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				joinDTO = dtos.JoinRoomDTO{}
+
+				summary := entities.Room{
+					ID:       roomID,
+					Author:   entities.Author{ID: "621f5e02e07fdbb81c8221f5"},
+					Settings: entities.RoomSettings{Visibility: entities.RoomVisibilityInviteOnly, InvitedDomains: []string{"example.com"}},
+				}
+
+				verifiedAt := time.Now()
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(summary, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(entities.User{ID: userID, Email: "ana@Example.com", VerifiedAt: &verifiedAt}, nil).Times(1)
+
+				mockSigner := securityMocks.NewMockSigner(mockCtrl)
+				mockSigner.EXPECT().Sign("room:621f5ec1e07fdbb81c8221f7:6117e377b6e7bae09f52c483", gomock.AssignableToTypeOf(time.Time{})).Return("grant").Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), mockSigner, mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should carry the grant", func() {
+				Expect(result.Grant).To(Equal("grant"))
+			})
+
+			It("error should be nil", func() {
+				Expect(joinRoomError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user is on an invited domain but has not verified the email", func() {
+			BeforeEach(func() {
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				joinDTO = dtos.JoinRoomDTO{}
+
+				summary := entities.Room{
+					ID:       roomID,
+					Author:   entities.Author{ID: "621f5e02e07fdbb81c8221f5"},
+					Settings: entities.RoomSettings{Visibility: entities.RoomVisibilityInviteOnly, InvitedDomains: []string{"example.com"}},
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(summary, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(entities.User{ID: userID, Email: "ana@example.com"}, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty grant", func() {
+				Expect(result).To(Equal(dtos.RoomGrantDTO{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(joinRoomError).To(Equal(application.NewForbiddenError("você não foi convidado para esta sala.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user is not invited", func() {
+			BeforeEach(func() {
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				joinDTO = dtos.JoinRoomDTO{}
+
+				summary := entities.Room{
+					ID:       roomID,
+					Author:   entities.Author{ID: "621f5e02e07fdbb81c8221f5"},
+					Settings: entities.RoomSettings{Visibility: entities.RoomVisibilityInviteOnly, InvitedDomains: []string{"example.com"}},
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(summary, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(entities.User{ID: userID, Email: "ana@another.com"}, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty grant", func() {
+				Expect(result).To(Equal(dtos.RoomGrantDTO{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(joinRoomError).To(Equal(application.NewForbiddenError("você não foi convidado para esta sala.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the FindQuestions function", func() {
+		var viewerID string
+		var roomID string
+		var query dtos.QuestionQueryDTO
+		var result dtos.QuestionPageDTO
+		var findQuestionsError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		var grant string
+
+		BeforeEach(func() {
+			grant = ""
+		})
+
+		JustBeforeEach(func() {
+			result, findQuestionsError = roomService.FindQuestions(viewerID, roomID, query, grant)
+		})
+
+		When("the FindQuestions function is executed with the default query", func() {
+			var expectedQuestions []entities.Question
+
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var roomWithQuestions entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &roomWithQuestions)
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedQuestions = roomWithQuestions.Questions
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				query = dtos.QuestionQueryDTO{}
+
+				expectedFilter := repositories.QuestionFilter{
+					Order:  repositories.QuestionOrderNewest,
+					Status: entities.QuestionStatusApproved,
+					Limit:  20,
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(entities.Room{ID: roomID}, nil).Times(1)
+				mockRoomRepository.EXPECT().FindQuestions(roomID, expectedFilter).Return(repositories.QuestionPage{
+					Questions:  expectedQuestions,
+					NextCursor: "next",
+				}, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should contain the page returned by roomRepository.FindQuestions", func() {
+				Expect(result).To(Equal(dtos.QuestionPageDTO{
+					Questions:  expectedQuestions,
+					NextCursor: "next",
+				}))
+			})
+
+			It("error should be nil", func() {
+				Expect(findQuestionsError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the FindQuestions function is executed with filters and ordering", func() {
+			BeforeEach(func() {
+				isAnswered := false
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				query = dtos.QuestionQueryDTO{
+					Order:      repositories.QuestionOrderMostLiked,
+					IsAnswered: &isAnswered,
+					Cursor:     "cursor",
+					Limit:      5,
+				}
+
+				expectedFilter := repositories.QuestionFilter{
+					Order:      repositories.QuestionOrderMostLiked,
+					Status:     entities.QuestionStatusApproved,
+					IsAnswered: &isAnswered,
+					Cursor:     "cursor",
+					Limit:      5,
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(entities.Room{ID: roomID}, nil).Times(1)
+				mockRoomRepository.EXPECT().FindQuestions(roomID, expectedFilter).Return(repositories.QuestionPage{Questions: []entities.Question{}}, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty page", func() {
+				Expect(result).To(Equal(dtos.QuestionPageDTO{Questions: []entities.Question{}}))
+			})
+
+			It("error should be nil", func() {
+				Expect(findQuestionsError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an attendee reads anonymous questions", func() {
+			var expectedFindQuestionsResult repositories.QuestionPage
+
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_anonymous_question.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(roomSerialized, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				viewerID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				query = dtos.QuestionQueryDTO{}
+
+				expectedFindQuestionsResult = repositories.QuestionPage{Questions: room.Questions}
+
+				summary := room
+				summary.Questions = nil
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindQuestions(roomID, gomock.AssignableToTypeOf(repositories.QuestionFilter{})).Return(expectedFindQuestionsResult, nil).Times(1)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(summary, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("the author of the anonymous question should be hidden", func() {
+				Expect(result.Questions).To(HaveLen(1))
+				Expect(result.Questions[0].Author).To(Equal(entities.Author{}))
+			})
+
+			It("error should be nil", func() {
+				Expect(findQuestionsError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while finding questions", func() {
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				query = dtos.QuestionQueryDTO{}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(entities.Room{ID: roomID}, nil).Times(1)
+				mockRoomRepository.EXPECT().FindQuestions(roomID, gomock.AssignableToTypeOf(repositories.QuestionFilter{})).Return(repositories.QuestionPage{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty page", func() {
+				Expect(result).To(Equal(dtos.QuestionPageDTO{}))
+			})
+
+			It("error should be the error returned by the roomRepository.FindQuestions function", func() {
+				Expect(findQuestionsError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the room is private and the viewer has no grant", func() {
+			BeforeEach(func() {
+				viewerID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				query = dtos.QuestionQueryDTO{}
+
+				summary := entities.Room{
+					ID:       roomID,
+					Author:   entities.Author{ID: "621f5e02e07fdbb81c8221f5"},
+					Settings: entities.RoomSettings{Visibility: entities.RoomVisibilityPasscode, Passcode: "hashed"},
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(summary, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty page", func() {
+				Expect(result).To(Equal(dtos.QuestionPageDTO{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(findQuestionsError).To(Equal(application.NewForbiddenError("esta sala é privada.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the room is private and the viewer has a valid grant", func() {
+			BeforeEach(func() {
+				viewerID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				query = dtos.QuestionQueryDTO{}
+				grant = "grant"
+
+				summary := entities.Room{
+					ID:       roomID,
+					Author:   entities.Author{ID: "621f5e02e07fdbb81c8221f5"},
+					Settings: entities.RoomSettings{Visibility: entities.RoomVisibilityPasscode, Passcode: "hashed"},
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(summary, nil).Times(1)
+				mockRoomRepository.EXPECT().FindQuestions(roomID, gomock.AssignableToTypeOf(repositories.QuestionFilter{})).Return(repositories.QuestionPage{Questions: []entities.Question{}}, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockSigner := securityMocks.NewMockSigner(mockCtrl)
+				mockSigner.EXPECT().Verify("grant").Return("room:621f5ec1e07fdbb81c8221f7:6117e377b6e7bae09f52c483", nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), mockSigner, mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be the page of questions", func() {
+				Expect(result).To(Equal(dtos.QuestionPageDTO{Questions: []entities.Question{}}))
+			})
+
+			It("error should be nil", func() {
+				Expect(findQuestionsError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the room is private and the grant was signed for another purpose", func() {
+			BeforeEach(func() {
+				viewerID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				query = dtos.QuestionQueryDTO{}
+				grant = "grant"
+
+				summary := entities.Room{
+					ID:       roomID,
+					Author:   entities.Author{ID: "621f5e02e07fdbb81c8221f5"},
+					Settings: entities.RoomSettings{Visibility: entities.RoomVisibilityPasscode, Passcode: "hashed"},
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(summary, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockSigner := securityMocks.NewMockSigner(mockCtrl)
+				mockSigner.EXPECT().Verify("grant").Return("verify:6117e377b6e7bae09f52c483:teste1@mail.com", nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), mockSigner, mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty QuestionPageDTO struct", func() {
+				Expect(result).To(Equal(dtos.QuestionPageDTO{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(findQuestionsError).To(Equal(application.NewForbiddenError("esta sala é privada.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the EndRoom function", func() {
+		var roomID string
+		var userID string
+		var result entities.Room
+		var endRoomError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, endRoomError = roomService.EndRoom(userID, roomID)
+		})
+
+		When("the EndRoom function is executed with success", func() {
+			var expectedEndRoomResult entities.Room
+
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				endedRoomSerialized, err := ioutil.ReadFile("../../../test/resources/ended_room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(endedRoomSerialized, &expectedEndRoomResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedEndRoomResult.Settings.InvitedUserIDs = []string{"6117e377b6e7bae09f52c483"}
+				expectedEndRoomResult.Settings.InvitedDomains = []string{"example.com"}
+				expectedEndRoomResult.Polls = []entities.Poll{{ID: "6221b7c4e07fdbb81c8221fd", Question: "Qual o melhor Symbian?", Status: entities.PollStatusDraft}}
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID = "621f5e02e07fdbb81c8221f5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Return(expectedEndRoomResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.RoomEndedEvent))
+					data, ok := event.Data.(entities.Room)
+					Expect(ok).To(BeTrue())
+					Expect(data.Settings.InvitedUserIDs).To(BeEmpty())
+					Expect(data.Settings.InvitedDomains).To(BeEmpty())
+					Expect(data.Polls).To(BeEmpty())
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.Update result", func() {
+				Expect(result).To(Equal(expectedEndRoomResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(endRoomError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while finding room by ID", func() {
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID = "621f5e02e07fdbb81c8221f5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the error returned by the roomRepository.FindByID function", func() {
+				Expect(endRoomError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user is only an attendee of the room", func() {
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID = "621f5e02e07fdbb81c8221l5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(endRoomError).To(Equal(application.NewForbiddenError("você não tem permissão para encerrar esta sala.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while updating room in database", func() {
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID = "621f5e02e07fdbb81c8221f5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the error returned by the roomRepository.Update function", func() {
+				Expect(endRoomError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the first attempt conflicts with a concurrent write", func() {
+			var expectedEndRoomResult entities.Room
+
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				endedRoomSerialized, err := ioutil.ReadFile("../../../test/resources/ended_room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(endedRoomSerialized, &expectedEndRoomResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID = "621f5e02e07fdbb81c8221f5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(2)
+				gomock.InOrder(
+					mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Return(entities.Room{}, domain.NewConflictError("a sala foi alterada por outra requisição")),
+					mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Return(expectedEndRoomResult, nil),
+				)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to the result of the retried roomRepository.Update", func() {
+				Expect(result).To(Equal(expectedEndRoomResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(endRoomError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("every attempt conflicts with a concurrent write", func() {
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID = "621f5e02e07fdbb81c8221f5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(3)
+				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Return(entities.Room{}, domain.NewConflictError("a sala foi alterada por outra requisição")).Times(3)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a conflict error", func() {
+				Expect(endRoomError).To(Equal(domain.NewConflictError("a sala foi alterada por outra requisição")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the room has already ended", func() {
+			BeforeEach(func() {
+				endedRoomSerialized, err := ioutil.ReadFile("../../../test/resources/ended_room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(endedRoomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID = "621f5e02e07fdbb81c8221f5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a room ended error", func() {
+				Expect(endRoomError).To(Equal(domain.NewRoomEndedError("a sala já foi encerrada.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the ReopenRoom function", func() {
+		var roomID string
+		var userID string
+		var result entities.Room
+		var reopenRoomError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, reopenRoomError = roomService.ReopenRoom(userID, roomID)
+		})
+
+		When("the owner reopens an ended room", func() {
+			var expectedReopenRoomResult entities.Room
+
+			BeforeEach(func() {
+				endedRoomSerialized, err := ioutil.ReadFile("../../../test/resources/ended_room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(endedRoomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedReopenRoomResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedReopenRoomResult.Settings.InvitedUserIDs = []string{"6117e377b6e7bae09f52c483"}
+				expectedReopenRoomResult.Settings.InvitedDomains = []string{"example.com"}
+				expectedReopenRoomResult.Polls = []entities.Poll{{ID: "6221b7c4e07fdbb81c8221fd", Question: "Qual o melhor Symbian?", Status: entities.PollStatusDraft}}
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID = "621f5e02e07fdbb81c8221f5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Do(func(roomID string, room entities.Room) {
+					Expect(room.EndedAt).To(BeNil())
+				}).Return(expectedReopenRoomResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.RoomReopenedEvent))
+					data, ok := event.Data.(entities.Room)
+					Expect(ok).To(BeTrue())
+					Expect(data.Settings.InvitedUserIDs).To(BeEmpty())
+					Expect(data.Settings.InvitedDomains).To(BeEmpty())
+					Expect(data.Polls).To(BeEmpty())
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.Update result", func() {
+				Expect(result).To(Equal(expectedReopenRoomResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(reopenRoomError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("someone other than the owner reopens the room", func() {
+			BeforeEach(func() {
+				endedRoomSerialized, err := ioutil.ReadFile("../../../test/resources/ended_room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(endedRoomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID = "6117e377b6e7bae09f52c483"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(reopenRoomError).To(Equal(application.NewForbiddenError("somente o dono da sala pode reabri-la.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the room has not ended", func() {
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID = "621f5e02e07fdbb81c8221f5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a conflict error", func() {
+				Expect(reopenRoomError).To(Equal(domain.NewConflictError("a sala não está encerrada.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the EndDueRooms function", func() {
+		var endDueRoomsError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			endDueRoomsError = roomService.EndDueRooms()
+		})
+
+		When("a room is past its scheduled end", func() {
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(roomSerialized, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				scheduledEndAt := time.Now().Add(-time.Minute)
+				room.ScheduledEndAt = &scheduledEndAt
+
+				endedRoom := room
+				endedRoom.EndedAt = &scheduledEndAt
+
+				endedRoom.Settings.InvitedUserIDs = []string{"6117e377b6e7bae09f52c483"}
+				endedRoom.Settings.InvitedDomains = []string{"example.com"}
+				endedRoom.Polls = []entities.Poll{{ID: "6221b7c4e07fdbb81c8221fd", Question: "Qual o melhor Symbian?", Status: entities.PollStatusDraft}}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindDueRooms(gomock.AssignableToTypeOf(time.Time{})).Return([]entities.Room{room}, nil).Times(1)
+				mockRoomRepository.EXPECT().FindByID(room.ID).Return(room, nil).Times(1)
+				mockRoomRepository.EXPECT().Update(room.ID, gomock.AssignableToTypeOf(entities.Room{})).Do(func(roomID string, room entities.Room) {
+					Expect(room.EndedAt).To(Equal(&scheduledEndAt))
+				}).Return(endedRoom, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.RoomEndedEvent))
+					Expect(event.RoomID).To(Equal(room.ID))
+					data, ok := event.Data.(entities.Room)
+					Expect(ok).To(BeTrue())
+					Expect(data.Settings.InvitedUserIDs).To(BeEmpty())
+					Expect(data.Settings.InvitedDomains).To(BeEmpty())
+					Expect(data.Polls).To(BeEmpty())
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("error should be nil", func() {
+				Expect(endDueRoomsError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while finding the due rooms", func() {
+			BeforeEach(func() {
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindDueRooms(gomock.AssignableToTypeOf(time.Time{})).Return([]entities.Room{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("error should be the error returned by the roomRepository.FindDueRooms function", func() {
+				Expect(endDueRoomsError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the CreateQuestion function", func() {
+		var userID string
+		var roomID string
+		var question dtos.QuestionDTO
+		var result entities.Room
+		var createQuestionError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, createQuestionError = roomService.CreateQuestion(userID, roomID, question, "")
+		})
+
+		When("the CreateQuestion function is executed with success", func() {
+			var expectedFindByIDResult entities.Room
+
+			BeforeEach(func() {
+				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				createdQuestion := expectedFindByIDResult.Questions[0]
+
+				userSerialized, err := ioutil.ReadFile("../../../test/resources/user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindUserByIDResult entities.User
+				err = json.Unmarshal(userSerialized, &expectedFindUserByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().PushQuestion(roomID, gomock.AssignableToTypeOf(entities.Question{}), true).Return(createdQuestion, nil).Times(1)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(2)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(expectedFindUserByIDResult, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.QuestionCreatedEvent))
+					Expect(event.Data).To(Equal(createdQuestion))
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.FindByID result", func() {
+				Expect(result).To(Equal(expectedFindByIDResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(createQuestionError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while pushing question in database", func() {
+			BeforeEach(func() {
+				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				userSerialized, err := ioutil.ReadFile("../../../test/resources/user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindUserByIDResult entities.User
+				err = json.Unmarshal(userSerialized, &expectedFindUserByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(entities.Room{ID: roomID}, nil).Times(1)
+				mockRoomRepository.EXPECT().PushQuestion(roomID, gomock.AssignableToTypeOf(entities.Question{}), true).Return(entities.Question{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(expectedFindUserByIDResult, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the error returned by the roomRepository.PushQuestion function", func() {
+				Expect(createQuestionError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while finding the updated room by ID", func() {
+			BeforeEach(func() {
+				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var roomWithQuestions entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &roomWithQuestions)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				userSerialized, err := ioutil.ReadFile("../../../test/resources/user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindUserByIDResult entities.User
+				err = json.Unmarshal(userSerialized, &expectedFindUserByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(roomWithQuestions, nil).Times(1)
+				mockRoomRepository.EXPECT().PushQuestion(roomID, gomock.AssignableToTypeOf(entities.Question{}), true).Return(roomWithQuestions.Questions[0], nil).Times(1)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(expectedFindUserByIDResult, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the error returned by the roomRepository.FindByID function", func() {
+				Expect(createQuestionError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while finding the author by ID", func() {
+			BeforeEach(func() {
+				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(entities.Room{ID: roomID}, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(entities.User{}, errors.New("an error")).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the error returned by the userRepository.FindByID function", func() {
+				Expect(createQuestionError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the room does not accept anonymous questions", func() {
+			BeforeEach(func() {
+				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_anonymous_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(entities.Room{ID: roomID}, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(createQuestionError).To(Equal(application.NewForbiddenError("esta sala não aceita perguntas anônimas.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an anonymous question is created", func() {
+			BeforeEach(func() {
+				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_anonymous_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_anonymous_question.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(roomSerialized, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5f40e07fdbb81c8221f8"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				createdQuestion := room.Questions[0]
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(room, nil).Times(2)
+				mockRoomRepository.EXPECT().PushQuestion(roomID, gomock.AssignableToTypeOf(entities.Question{}), true).Do(func(roomID string, question entities.Question, limited bool) {
+					Expect(question.IsAnonymous).To(BeTrue())
+					Expect(question.Author.ID).To(Equal(userID))
+				}).Return(createdQuestion, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(entities.User{ID: userID, Name: "Teste 2"}, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Data.(entities.Question).Author).To(Equal(entities.Author{}))
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("the author should be hidden from the asker too", func() {
+				Expect(result.Questions[0].Author).To(Equal(entities.Author{}))
+			})
+
+			It("error should be nil", func() {
+				Expect(createQuestionError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the room requires questions to be approved", func() {
+			BeforeEach(func() {
+				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_pending_question.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(roomSerialized, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				question = dtos.QuestionDTO{}
+				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5f40e07fdbb81c8221f8"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(room, nil).Times(2)
+				mockRoomRepository.EXPECT().PushQuestion(roomID, gomock.AssignableToTypeOf(entities.Question{}), true).Do(func(roomID string, question entities.Question, limited bool) {
+					Expect(question.Status).To(Equal(entities.QuestionStatusPending))
+				}).Return(room.Questions[0], nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(entities.User{ID: userID, Name: "Teste 2"}, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("the pending question should not be shown to the asker", func() {
+				Expect(result.Questions).To(BeEmpty())
+			})
+
+			It("error should be nil", func() {
+				Expect(createQuestionError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while finding room by ID", func() {
+			BeforeEach(func() {
+				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the error returned by the roomRepository.FindByID function", func() {
+				Expect(createQuestionError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the room has not opened yet", func() {
+			BeforeEach(func() {
+				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(roomSerialized, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				scheduledStartAt := time.Now().Add(time.Hour)
+				room.ScheduledStartAt = &scheduledStartAt
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(room, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a conflict error", func() {
+				Expect(createQuestionError).To(Equal(domain.NewConflictError("a sala ainda não foi aberta.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the scheduled end of the room has passed", func() {
+			BeforeEach(func() {
+				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(roomSerialized, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				scheduledEndAt := time.Now().Add(-time.Minute)
+				room.ScheduledEndAt = &scheduledEndAt
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(room, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a room ended error", func() {
+				Expect(createQuestionError).To(Equal(domain.NewRoomEndedError("a sala já foi encerrada.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user already asked as many questions as the room allows", func() {
+			BeforeEach(func() {
+				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5f40e07fdbb81c8221f8"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				expectedFindByIDResult.Settings.MaxQuestionsPerUser = 1
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a conflict error", func() {
+				Expect(createQuestionError).To(Equal(domain.NewConflictError("você atingiu o limite de 1 perguntas por pessoa nesta sala.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user asked another question too recently", func() {
+			BeforeEach(func() {
+				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5f40e07fdbb81c8221f8"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				expectedFindByIDResult.Settings.MinSecondsBetweenQuestions = 60
+				expectedFindByIDResult.Questions[0].CreatedAt = time.Now()
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a rate limit error telling how long to wait", func() {
+				var rateLimitError *domain.RateLimitError
+				Expect(errors.As(createQuestionError, &rateLimitError)).To(BeTrue())
+				Expect(rateLimitError.RetryAfter).To(BeNumerically(">", 59*time.Second))
+				Expect(rateLimitError.RetryAfter).To(BeNumerically("<=", 60*time.Second))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user has too many questions waiting for an answer", func() {
+			BeforeEach(func() {
+				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5f40e07fdbb81c8221f8"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				expectedFindByIDResult.Settings.MaxOpenQuestionsPerUser = 1
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a conflict error", func() {
+				Expect(createQuestionError).To(Equal(domain.NewConflictError("espere que suas perguntas sejam respondidas antes de fazer outras.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("another question of the user gets in while the limits are checked", func() {
+			BeforeEach(func() {
+				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var updatedRoom entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &updatedRoom)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5f40e07fdbb81c8221f8"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				updatedRoom.Settings.MaxQuestionsPerUser = 1
+
+				staleRoom := updatedRoom
+				staleRoom.Questions = nil
+
+				userSerialized, err := ioutil.ReadFile("../../../test/resources/user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindUserByIDResult entities.User
+				err = json.Unmarshal(userSerialized, &expectedFindUserByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				gomock.InOrder(
+					mockRoomRepository.EXPECT().FindByID(roomID).Return(staleRoom, nil).Times(1),
+					mockRoomRepository.EXPECT().PushQuestion(roomID, gomock.AssignableToTypeOf(entities.Question{}), true).Return(entities.Question{}, domain.NewConflictError("a sala foi alterada por outra requisição")).Times(1),
+					mockRoomRepository.EXPECT().FindByID(roomID).Return(updatedRoom, nil).Times(1),
+				)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(expectedFindUserByIDResult, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the limit the room reached in the meantime", func() {
+				Expect(createQuestionError).To(Equal(domain.NewConflictError("você atingiu o limite de 1 perguntas por pessoa nesta sala.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the owner asks a question in a room with question limits", func() {
+			BeforeEach(func() {
+				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				question = dtos.QuestionDTO{}
+				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				room.Settings.MaxQuestionsPerUser = 1
+				room.Settings.MaxOpenQuestionsPerUser = 1
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(room, nil).Times(2)
+				mockRoomRepository.EXPECT().PushQuestion(roomID, gomock.AssignableToTypeOf(entities.Question{}), false).Return(room.Questions[0], nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(entities.User{ID: userID, Name: "Teste 1"}, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("error should be nil", func() {
+				Expect(createQuestionError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the UpdateQuestion function", func() {
+		var userID string
+		var roomID string
+		var questionID string
+		var questionData dtos.UpdateQuestionDTO
+		var result entities.Room
+		var updateQuestionError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		BeforeEach(func() {
+			questionData = dtos.UpdateQuestionDTO{}
+		})
+
+		JustBeforeEach(func() {
+			result, updateQuestionError = roomService.UpdateQuestion(userID, roomID, questionID, questionData)
+		})
+
+		When("the UpdateQuestion function is executed to highlight question with success", func() {
+			var expectedUpdateQuestionResult entities.Room
+
+			BeforeEach(func() {
+				highlightQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/highlight_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionHighlightedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_highlighted.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionHighlightedSerialized, &expectedUpdateQuestionResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(highlightQuestionRequestSerialized, &questionData)
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().SetQuestionHighlight(roomID, questionID, true).Return(expectedUpdateQuestionResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.QuestionHighlightedEvent))
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.SetQuestionHighlight result", func() {
+				Expect(result).To(Equal(expectedUpdateQuestionResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(updateQuestionError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the UpdateQuestion function is executed to mark question as answered with success", func() {
+			var expectedUpdateQuestionResult entities.Room
+
+			BeforeEach(func() {
+				markQuestionAsAnsweredRequestSerialized, err := ioutil.ReadFile("../../../test/resources/mark_question_as_answered_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionAnsweredSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_answered.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionAnsweredSerialized, &expectedUpdateQuestionResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(markQuestionAsAnsweredRequestSerialized, &questionData)
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().MarkQuestionAsAnswered(roomID, questionID, "").Return(expectedUpdateQuestionResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.QuestionAnsweredEvent))
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.MarkQuestionAsAnswered result", func() {
+				Expect(result).To(Equal(expectedUpdateQuestionResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(updateQuestionError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while finding room by ID", func() {
+			BeforeEach(func() {
+				markQuestionAsAnsweredRequestSerialized, err := ioutil.ReadFile("../../../test/resources/mark_question_as_answered_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(markQuestionAsAnsweredRequestSerialized, &questionData)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the error returned by the roomRepository.FindByID function", func() {
+				Expect(updateQuestionError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user is only an attendee of the room", func() {
+			BeforeEach(func() {
+				markQuestionAsAnsweredRequestSerialized, err := ioutil.ReadFile("../../../test/resources/mark_question_as_answered_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(markQuestionAsAnsweredRequestSerialized, &questionData)
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5e02e07fdbb81c8221e5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(updateQuestionError).To(Equal(application.NewForbiddenError("você não tem permissão para atualizar as perguntas desta sala.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while updating question in database", func() {
+			BeforeEach(func() {
+				markQuestionAsAnsweredRequestSerialized, err := ioutil.ReadFile("../../../test/resources/mark_question_as_answered_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(markQuestionAsAnsweredRequestSerialized, &questionData)
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().MarkQuestionAsAnswered(roomID, questionID, "").Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the error returned by the roomRepository.MarkQuestionAsAnswered function", func() {
+				Expect(updateQuestionError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+		When("the question is marked as answered by an official reply", func() {
+			var expectedUpdateQuestionResult entities.Room
+
+			BeforeEach(func() {
+				roomWithQuestionRepliedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_replied.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionRepliedSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionRepliedSerialized, &expectedUpdateQuestionResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedUpdateQuestionResult.Questions[0].IsAnswered = true
+				expectedUpdateQuestionResult.Questions[0].AnswerID = "6220a3d1e07fdbb81c8221fa"
+
+				isAnswered := true
+				answerID := "6220a3d1e07fdbb81c8221fa"
+				questionData = dtos.UpdateQuestionDTO{IsAnswered: &isAnswered, AnswerID: &answerID}
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().MarkQuestionAsAnswered(roomID, questionID, answerID).Return(expectedUpdateQuestionResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.QuestionAnsweredEvent))
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.MarkQuestionAsAnswered result", func() {
+				Expect(result).To(Equal(expectedUpdateQuestionResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(updateQuestionError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the reply linked as the answer is not official", func() {
+			BeforeEach(func() {
+				roomWithQuestionRepliedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_replied.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionRepliedSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				isAnswered := true
+				answerID := "6220a402e07fdbb81c8221fb"
+				questionData = dtos.UpdateQuestionDTO{IsAnswered: &isAnswered, AnswerID: &answerID}
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a bad request error", func() {
+				Expect(updateQuestionError).To(Equal(domain.NewBadRequestError("somente uma resposta oficial pode ser vinculada à pergunta.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the owner marks a question as answered during the grace period", func() {
+			var expectedUpdateQuestionResult entities.Room
+
+			BeforeEach(func() {
+				markQuestionAsAnsweredRequestSerialized, err := ioutil.ReadFile("../../../test/resources/mark_question_as_answered_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				endedRoomSerialized, err := ioutil.ReadFile("../../../test/resources/ended_room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionAnsweredSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_answered.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionAnsweredSerialized, &expectedUpdateQuestionResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(markQuestionAsAnsweredRequestSerialized, &questionData)
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(endedRoomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				endedAt := time.Now().Add(-5 * time.Minute)
+				expectedFindByIDResult.EndedAt = &endedAt
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().MarkQuestionAsAnswered(roomID, questionID, "").Return(expectedUpdateQuestionResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.QuestionAnsweredEvent))
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.MarkQuestionAsAnswered result", func() {
+				Expect(result).To(Equal(expectedUpdateQuestionResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(updateQuestionError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the grace period after the end of the room is over", func() {
+			BeforeEach(func() {
+				markQuestionAsAnsweredRequestSerialized, err := ioutil.ReadFile("../../../test/resources/mark_question_as_answered_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				endedRoomSerialized, err := ioutil.ReadFile("../../../test/resources/ended_room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(markQuestionAsAnsweredRequestSerialized, &questionData)
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(endedRoomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a room ended error", func() {
+				Expect(updateQuestionError).To(Equal(domain.NewRoomEndedError("a sala já foi encerrada.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the question is still waiting for moderation", func() {
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_pending_question.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				isHighlighted := true
+				questionData = dtos.UpdateQuestionDTO{IsHighlighted: &isHighlighted}
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a conflict error", func() {
+				Expect(updateQuestionError).To(Equal(domain.NewConflictError("somente perguntas publicadas podem ser destacadas ou respondidas.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the LikeQuestion function", func() {
+		var roomID string
+		var questionID string
+		var userID string
+		var result entities.Room
+		var likeQuestionError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, likeQuestionError = roomService.LikeQuestion(userID, roomID, questionID, "")
+		})
+
+		When("the LikeQuestion function is executed with success", func() {
+			var expectedLikeQuestionResult entities.Room
+
+			BeforeEach(func() {
+				userSerialized, err := ioutil.ReadFile("../../../test/resources/user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindUserByIDResult entities.User
+				err = json.Unmarshal(userSerialized, &expectedFindUserByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionLikedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_liked.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionLikedSerialized, &expectedLikeQuestionResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().AddLike(roomID, questionID, gomock.AssignableToTypeOf(entities.Like{})).Return(expectedLikeQuestionResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(expectedFindUserByIDResult, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.QuestionLikedEvent))
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.AddLike result", func() {
+				Expect(result).To(Equal(expectedLikeQuestionResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(likeQuestionError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while finding room by ID", func() {
+			BeforeEach(func() {
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the error returned by the roomRepository.FindByID function", func() {
+				Expect(likeQuestionError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("try to like question that does not exists", func() {
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c82213f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should a ResourceNotFoundError", func() {
+				Expect(likeQuestionError).To(Equal(domain.NewResourceNotFoundError("pergunta não encontrada.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while adding like in database", func() {
+			BeforeEach(func() {
+				userSerialized, err := ioutil.ReadFile("../../../test/resources/user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindUserByIDResult entities.User
+				err = json.Unmarshal(userSerialized, &expectedFindUserByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().AddLike(roomID, questionID, gomock.AssignableToTypeOf(entities.Like{})).Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(expectedFindUserByIDResult, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the error returned by the roomRepository.AddLike function", func() {
+				Expect(likeQuestionError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the room has ended", func() {
+			BeforeEach(func() {
+				endedRoomSerialized, err := ioutil.ReadFile("../../../test/resources/ended_room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(endedRoomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a room ended error", func() {
+				Expect(likeQuestionError).To(Equal(domain.NewRoomEndedError("a sala já foi encerrada.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user has already liked the question", func() {
+			BeforeEach(func() {
+				userSerialized, err := ioutil.ReadFile("../../../test/resources/user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindUserByIDResult entities.User
+				err = json.Unmarshal(userSerialized, &expectedFindUserByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionLikedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_liked.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionLikedSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().AddLike(roomID, questionID, gomock.AssignableToTypeOf(entities.Like{})).Return(entities.Room{}, domain.NewConflictError("você já curtiu esta pergunta.")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(expectedFindUserByIDResult, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a conflict error", func() {
+				Expect(likeQuestionError).To(Equal(domain.NewConflictError("você já curtiu esta pergunta.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the DeslikeQuestion function", func() {
+		var userID string
+		var roomID string
+		var questionID string
+		var likeID string
+		var result entities.Room
+		var deslikeQuestionError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, deslikeQuestionError = roomService.DeslikeQuestion(userID, roomID, questionID, likeID)
+		})
+
+		When("the DeslikeQuestion function is executed with success", func() {
+			var expectedDeslikeQuestionResult entities.Room
+
+			BeforeEach(func() {
+				roomWithQuestionLikedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_liked.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionLikedSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedDeslikeQuestionResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				likeID = "6176903081f4f3f262acd6b4"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().RemoveLike(roomID, questionID, likeID).Return(expectedDeslikeQuestionResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.QuestionUnlikedEvent))
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.RemoveLike result", func() {
+				Expect(result).To(Equal(expectedDeslikeQuestionResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(deslikeQuestionError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while finding room by ID", func() {
+			BeforeEach(func() {
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				likeID = "6176903081f4f3f262acd6b4"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the error returned by the roomRepository.FindByID function", func() {
+				Expect(deslikeQuestionError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("try to deslike question that does not exists", func() {
+			BeforeEach(func() {
+				roomWithQuestionLikedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_liked.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionLikedSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8421f9"
+				likeID = "6176903081f4f3f262acd6b4"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should a ResourceNotFoundError", func() {
+				Expect(deslikeQuestionError).To(Equal(domain.NewResourceNotFoundError("pergunta não encontrada.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while removing like in database", func() {
+			BeforeEach(func() {
+				roomWithQuestionLikedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_liked.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionLikedSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				likeID = "6176903081f4f3f262acd6b4"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().RemoveLike(roomID, questionID, likeID).Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the error returned by the roomRepository.RemoveLike function", func() {
+				Expect(deslikeQuestionError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the like belongs to someone else", func() {
+			BeforeEach(func() {
+				roomWithQuestionLikedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_liked.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionLikedSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5f40e07fdbb81c8221f8"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				likeID = "6176903081f4f3f262acd6b4"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(deslikeQuestionError).To(Equal(application.NewForbiddenError("você não pode remover uma curtida que não é sua.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the room owner removes someone else's like", func() {
+			var expectedDeslikeQuestionResult entities.Room
+
+			BeforeEach(func() {
+				roomWithQuestionLikedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_liked.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionLikedSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedDeslikeQuestionResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				likeID = "6176903081f4f3f262acd6b4"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().RemoveLike(roomID, questionID, likeID).Return(expectedDeslikeQuestionResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.RemoveLike result", func() {
+				Expect(result).To(Equal(expectedDeslikeQuestionResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(deslikeQuestionError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("try to remove a like that does not exists", func() {
+			BeforeEach(func() {
+				roomWithQuestionLikedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_liked.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionLikedSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				likeID = "6176903081f4f3f262acd6b5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should a ResourceNotFoundError", func() {
+				Expect(deslikeQuestionError).To(Equal(domain.NewResourceNotFoundError("curtida não encontrada.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the DeleteQuestion function", func() {
+		var roomID string
+		var userID string
+		var questionID string
+		var result entities.Room
+		var deleteQuestionError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, deleteQuestionError = roomService.DeleteQuestion(userID, roomID, questionID)
+		})
+
+		When("the DeleteQuestion function is executed with success", func() {
+			var expectedDeleteQuestionResult entities.Room
+
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomSerialized, &expectedDeleteQuestionResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				userID = "621f5e02e07fdbb81c8221f5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().MarkQuestionAsDeleted(roomID, questionID, gomock.AssignableToTypeOf(entities.QuestionDeletion{})).Do(func(roomID string, questionID string, deletion entities.QuestionDeletion) {
+					Expect(deletion.Reason).To(Equal(entities.QuestionRemoved))
+					Expect(deletion.DeletedBy).To(Equal(userID))
+				}).Return(expectedDeleteQuestionResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.QuestionDeletedEvent))
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.MarkQuestionAsDeleted result", func() {
+				Expect(result).To(Equal(expectedDeleteQuestionResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(deleteQuestionError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while finding room by ID", func() {
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				userID = "621f5e02e07fdbb81c8221f5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the error returned by the roomRepository.FindByID function", func() {
+				Expect(deleteQuestionError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user is only an attendee of the room", func() {
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				userID = "621f5e02e07fdbb81e8221f5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(deleteQuestionError).To(Equal(application.NewForbiddenError("você não tem permissão para remover as perguntas desta sala.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while marking the question as deleted in database", func() {
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				userID = "621f5e02e07fdbb81c8221f5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().MarkQuestionAsDeleted(roomID, questionID, gomock.AssignableToTypeOf(entities.QuestionDeletion{})).Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the error returned by the roomRepository.MarkQuestionAsDeleted function", func() {
+				Expect(deleteQuestionError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the author withdraws the question", func() {
+			var expectedDeleteQuestionResult entities.Room
+
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomSerialized, &expectedDeleteQuestionResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				userID = "621f5f40e07fdbb81c8221f8"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().MarkQuestionAsDeleted(roomID, questionID, gomock.AssignableToTypeOf(entities.QuestionDeletion{})).Do(func(roomID string, questionID string, deletion entities.QuestionDeletion) {
+					Expect(deletion.Reason).To(Equal(entities.QuestionWithdrawn))
+					Expect(deletion.DeletedBy).To(Equal(userID))
+				}).Return(expectedDeleteQuestionResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.QuestionDeletedEvent))
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.MarkQuestionAsDeleted result", func() {
+				Expect(result).To(Equal(expectedDeleteQuestionResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(deleteQuestionError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the question was already deleted", func() {
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedFindByIDResult.Questions[0].Deletion = &entities.QuestionDeletion{
+					Reason:    entities.QuestionWithdrawn,
+					DeletedBy: "621f5f40e07fdbb81c8221f8",
+					DeletedAt: time.Now(),
+				}
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				userID = "621f5e02e07fdbb81c8221f5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a resource not found error", func() {
+				Expect(deleteQuestionError).To(Equal(domain.NewResourceNotFoundError("pergunta não encontrada.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the room has ended", func() {
+			BeforeEach(func() {
+				endedRoomSerialized, err := ioutil.ReadFile("../../../test/resources/ended_room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(endedRoomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID = "621f5e02e07fdbb81c8221f5"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a room ended error", func() {
+				Expect(deleteQuestionError).To(Equal(domain.NewRoomEndedError("a sala já foi encerrada.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the AddMember function", func() {
+		var userID string
+		var roomID string
+		var memberDTO dtos.MemberDTO
+		var result entities.Room
+		var addMemberError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, addMemberError = roomService.AddMember(userID, roomID, memberDTO)
+		})
+
+		When("the room owner adds a moderator", func() {
+			var expectedUpdateResult entities.Room
+
+			BeforeEach(func() {
+				addMemberRequestSerialized, err := ioutil.ReadFile("../../../test/resources/add_member_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				userSerialized, err := ioutil.ReadFile("../../../test/resources/user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(addMemberRequestSerialized, &memberDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				var member entities.User
+				err = json.Unmarshal(userSerialized, &member)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				expectedUpdateResult = expectedFindByIDResult
+				expectedUpdateResult.Members = []entities.Member{{User: member.ToAuthor(), Role: entities.RoomRoleModerator}}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Do(func(roomID string, room entities.Room) {
+					Expect(room.Members).To(HaveLen(1))
+					Expect(room.Members[0].User).To(Equal(member.ToAuthor()))
+					Expect(room.Members[0].Role).To(Equal(entities.RoomRoleModerator))
+				}).Return(expectedUpdateResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(memberDTO.UserID).Return(member, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.MemberAddedEvent))
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.Update result", func() {
+				Expect(result).To(Equal(expectedUpdateResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(addMemberError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user is not allowed to manage the members", func() {
+			BeforeEach(func() {
+				addMemberRequestSerialized, err := ioutil.ReadFile("../../../test/resources/add_member_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				userSerialized, err := ioutil.ReadFile("../../../test/resources/user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(addMemberRequestSerialized, &memberDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				var member entities.User
+				err = json.Unmarshal(userSerialized, &member)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5f40e07fdbb81c8221f8"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(memberDTO.UserID).Return(member, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(addMemberError).To(Equal(application.NewForbiddenError("você não tem permissão para gerenciar os membros desta sala.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("a co-host tries to appoint another co-host", func() {
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				userSerialized, err := ioutil.ReadFile("../../../test/resources/user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				var member entities.User
+				err = json.Unmarshal(userSerialized, &member)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5f40e07fdbb81c8221f8"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				memberDTO = dtos.MemberDTO{UserID: member.ID, Role: entities.RoomRoleCoHost}
+
+				expectedFindByIDResult.Members = []entities.Member{
+					{User: entities.Author{ID: userID}, Role: entities.RoomRoleCoHost},
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(memberDTO.UserID).Return(member, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(addMemberError).To(Equal(application.NewForbiddenError("somente o dono da sala pode gerenciar os co-anfitriões.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the member being added is the room owner", func() {
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				memberDTO = dtos.MemberDTO{UserID: userID, Role: entities.RoomRoleModerator}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(entities.User{ID: userID}, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a bad request error", func() {
+				Expect(addMemberError).To(Equal(domain.NewBadRequestError("o papel do dono da sala não pode ser alterado.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while finding the user to be added", func() {
+			BeforeEach(func() {
+				addMemberRequestSerialized, err := ioutil.ReadFile("../../../test/resources/add_member_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(addMemberRequestSerialized, &memberDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(memberDTO.UserID).Return(entities.User{}, errors.New("an error")).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the error returned by the userRepository.FindByID function", func() {
+				Expect(addMemberError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the RemoveMember function", func() {
+		var userID string
+		var roomID string
+		var memberID string
+		var result entities.Room
+		var removeMemberError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, removeMemberError = roomService.RemoveMember(userID, roomID, memberID)
+		})
+
+		When("the room owner removes a moderator", func() {
+			var expectedUpdateResult entities.Room
+
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomSerialized, &expectedUpdateResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				memberID = "6117e377b6e7bae09f52c483"
+
+				expectedFindByIDResult.Members = []entities.Member{
+					{User: entities.Author{ID: memberID}, Role: entities.RoomRoleModerator},
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Do(func(roomID string, room entities.Room) {
+					Expect(room.Members).To(BeEmpty())
+				}).Return(expectedUpdateResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.MemberRemovedEvent))
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.Update result", func() {
+				Expect(result).To(Equal(expectedUpdateResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(removeMemberError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("a co-host leaves the room on their own", func() {
+			var expectedUpdateResult entities.Room
+
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomSerialized, &expectedUpdateResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				memberID = userID
+
+				expectedFindByIDResult.Members = []entities.Member{
+					{User: entities.Author{ID: memberID}, Role: entities.RoomRoleCoHost},
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Return(expectedUpdateResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.Update result", func() {
+				Expect(result).To(Equal(expectedUpdateResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(removeMemberError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("a moderator tries to remove another member", func() {
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5f40e07fdbb81c8221f8"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				memberID = "6117e377b6e7bae09f52c483"
+
+				expectedFindByIDResult.Members = []entities.Member{
+					{User: entities.Author{ID: userID}, Role: entities.RoomRoleModerator},
+					{User: entities.Author{ID: memberID}, Role: entities.RoomRoleModerator},
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(removeMemberError).To(Equal(application.NewForbiddenError("você não tem permissão para gerenciar os membros desta sala.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user is not a member of the room", func() {
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				memberID = "6117e377b6e7bae09f52c483"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a resource not found error", func() {
+				Expect(removeMemberError).To(Equal(domain.NewResourceNotFoundError("membro não encontrado.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the member being removed is the room owner", func() {
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				memberID = userID
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a bad request error", func() {
+				Expect(removeMemberError).To(Equal(domain.NewBadRequestError("o dono não pode ser removido da sala.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the UpdateSettings function", func() {
+		var userID string
+		var roomID string
+		var settingsDTO dtos.RoomSettingsDTO
+		var result entities.Room
+		var updateSettingsError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, updateSettingsError = roomService.UpdateSettings(userID, roomID, settingsDTO)
+		})
+
+		When("the room owner allows anonymous questions", func() {
+			var expectedUpdateResult entities.Room
+
+			BeforeEach(func() {
+				updateRoomSettingsRequestSerialized, err := ioutil.ReadFile("../../../test/resources/update_room_settings_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(updateRoomSettingsRequestSerialized, &settingsDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				expectedUpdateResult = expectedFindByIDResult
+				expectedUpdateResult.Settings.AllowAnonymousQuestions = true
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Do(func(roomID string, room entities.Room) {
+					Expect(room.Settings.AllowAnonymousQuestions).To(BeTrue())
+				}).Return(expectedUpdateResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.RoomSettingsUpdatedEvent))
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.Update result", func() {
+				Expect(result).To(Equal(expectedUpdateResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(updateSettingsError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user is only an attendee of the room", func() {
+			BeforeEach(func() {
+				updateRoomSettingsRequestSerialized, err := ioutil.ReadFile("../../../test/resources/update_room_settings_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(updateRoomSettingsRequestSerialized, &settingsDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(updateSettingsError).To(Equal(application.NewForbiddenError("você não tem permissão para alterar as configurações desta sala.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the room owner protects the room with a passcode", func() {
+			BeforeEach(func() {
+				visibility := entities.RoomVisibilityPasscode
+				passcode := "1234"
+				settingsDTO = dtos.RoomSettingsDTO{Visibility: &visibility, Passcode: &passcode}
+
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).DoAndReturn(func(roomID string, room entities.Room) (entities.Room, error) {
+					Expect(room.Settings.Visibility).To(Equal(entities.RoomVisibilityPasscode))
+					Expect(room.Settings.Passcode).To(Equal("hashed"))
+					return room, nil
+				}).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+				mockSecurityProvider.EXPECT().Hash("1234").Return("hashed", nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, mockSecurityProvider, securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("the room should be protected by the passcode", func() {
+				Expect(result.Settings.Visibility).To(Equal(entities.RoomVisibilityPasscode))
+			})
+
+			It("error should be nil", func() {
+				Expect(updateSettingsError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the room owner asks for a passcode without setting one", func() {
+			BeforeEach(func() {
+				visibility := entities.RoomVisibilityPasscode
+				settingsDTO = dtos.RoomSettingsDTO{Visibility: &visibility}
+
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a bad request error", func() {
+				Expect(updateSettingsError).To(Equal(domain.NewBadRequestError("defina um código de acesso para a sala.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the FindPendingQuestions function", func() {
+		var userID string
+		var roomID string
+		var query dtos.QuestionQueryDTO
+		var result dtos.QuestionPageDTO
+		var findPendingQuestionsError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, findPendingQuestionsError = roomService.FindPendingQuestions(userID, roomID, query)
+		})
+
+		When("the room owner lists the moderation queue", func() {
+			var room entities.Room
+
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_pending_question.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomSerialized, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				query = dtos.QuestionQueryDTO{}
+
+				expectedFilter := repositories.QuestionFilter{
+					Order:  repositories.QuestionOrderOldest,
+					Status: entities.QuestionStatusPending,
+					Limit:  20,
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(room, nil).Times(1)
+				mockRoomRepository.EXPECT().FindQuestions(roomID, expectedFilter).Return(repositories.QuestionPage{Questions: room.Questions}, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should contain the pending questions", func() {
+				Expect(result).To(Equal(dtos.QuestionPageDTO{Questions: room.Questions}))
+			})
+
+			It("error should be nil", func() {
+				Expect(findPendingQuestionsError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user is only an attendee of the room", func() {
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_pending_question.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(roomSerialized, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				query = dtos.QuestionQueryDTO{}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(room, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty page", func() {
+				Expect(result).To(Equal(dtos.QuestionPageDTO{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(findPendingQuestionsError).To(Equal(application.NewForbiddenError("você não tem permissão para moderar as perguntas desta sala.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the ApproveQuestion function", func() {
+		var userID string
+		var roomID string
+		var questionID string
+		var result entities.Room
+		var approveQuestionError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, approveQuestionError = roomService.ApproveQuestion(userID, roomID, questionID)
+		})
+
+		When("the room owner approves a pending question", func() {
+			var expectedSetQuestionStatusResult entities.Room
+
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_pending_question.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomSerialized, &expectedSetQuestionStatusResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedSetQuestionStatusResult.Questions[0].Status = entities.QuestionStatusApproved
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().SetQuestionStatus(roomID, questionID, entities.QuestionStatusApproved).Return(expectedSetQuestionStatusResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.QuestionApprovedEvent))
+					Expect(event.Data).To(Equal(expectedSetQuestionStatusResult.Questions[0]))
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.SetQuestionStatus result", func() {
+				Expect(result).To(Equal(expectedSetQuestionStatusResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(approveQuestionError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the question is not waiting for moderation", func() {
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a conflict error", func() {
+				Expect(approveQuestionError).To(Equal(domain.NewConflictError("a pergunta não está aguardando moderação.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user is only an attendee of the room", func() {
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_pending_question.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5f40e07fdbb81c8221f8"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(approveQuestionError).To(Equal(application.NewForbiddenError("você não tem permissão para moderar as perguntas desta sala.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the RejectQuestion function", func() {
+		var userID string
+		var roomID string
+		var questionID string
+		var result entities.Room
+		var rejectQuestionError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, rejectQuestionError = roomService.RejectQuestion(userID, roomID, questionID)
+		})
+
+		When("the room owner rejects a pending question", func() {
+			var expectedSetQuestionStatusResult entities.Room
+
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_pending_question.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomSerialized, &expectedSetQuestionStatusResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedSetQuestionStatusResult.Questions[0].Status = entities.QuestionStatusRejected
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().SetQuestionStatus(roomID, questionID, entities.QuestionStatusRejected).Return(expectedSetQuestionStatusResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.SetQuestionStatus result", func() {
+				Expect(result).To(Equal(expectedSetQuestionStatusResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(rejectQuestionError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the CreateReply function", func() {
+		var roomID string
+		var userID string
+		var questionID string
+		var replyDTO dtos.ReplyDTO
+		var result entities.Room
+		var createReplyError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, createReplyError = roomService.CreateReply(userID, roomID, questionID, replyDTO, "")
+		})
+
+		When("an attendee replies to a question", func() {
+			var expectedCreateReplyResult entities.Room
+
+			BeforeEach(func() {
+				createReplyRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_reply_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionRepliedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_replied.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				userSerialized, err := ioutil.ReadFile("../../../test/resources/user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				replyDTO = dtos.ReplyDTO{}
+				err = json.Unmarshal(createReplyRequestSerialized, &replyDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionRepliedSerialized, &expectedCreateReplyResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				var user entities.User
+				err = json.Unmarshal(userSerialized, &user)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = user.ID
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().AddReply(roomID, questionID, gomock.AssignableToTypeOf(entities.Reply{})).Do(func(roomID string, questionID string, reply entities.Reply) {
+					Expect(reply.Content).To(Equal(replyDTO.Content))
+					Expect(reply.Author).To(Equal(user.ToAuthor()))
+					Expect(reply.IsOfficial).To(BeFalse())
+				}).Return(expectedCreateReplyResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(user, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.ReplyCreatedEvent))
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be equal to expected roomRepository.AddReply result", func() {
+				Expect(result).To(Equal(expectedCreateReplyResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(createReplyError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an attendee tries to give an official answer", func() {
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				replyDTO = dtos.ReplyDTO{Content: "Foi sim.", IsOfficial: true}
+
+				userID = "621f5f40e07fdbb81c8221f8"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(createReplyError).To(Equal(application.NewForbiddenError("você não tem permissão para dar respostas oficiais nesta sala.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the question is still waiting for moderation", func() {
+			BeforeEach(func() {
+				roomWithPendingQuestionSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_pending_question.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithPendingQuestionSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				replyDTO = dtos.ReplyDTO{Content: "Foi sim."}
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = expectedFindByIDResult.Questions[0].ID
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a resource not found error", func() {
+				Expect(createReplyError).To(Equal(domain.NewResourceNotFoundError("pergunta não encontrada.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while adding the reply in database", func() {
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				userSerialized, err := ioutil.ReadFile("../../../test/resources/user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				var user entities.User
+				err = json.Unmarshal(userSerialized, &user)
+				Expect(err).NotTo(HaveOccurred())
+
+				replyDTO = dtos.ReplyDTO{Content: "Foi sim."}
+
+				userID = user.ID
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().AddReply(roomID, questionID, gomock.AssignableToTypeOf(entities.Reply{})).Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(user, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be the error returned by the roomRepository.AddReply function", func() {
+				Expect(createReplyError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the room is private and the user has no grant", func() {
+			BeforeEach(func() {
+				createReplyRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_reply_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				replyDTO = dtos.ReplyDTO{}
+				err = json.Unmarshal(createReplyRequestSerialized, &replyDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedFindByIDResult.Settings.Visibility = entities.RoomVisibilityPasscode
+				expectedFindByIDResult.Settings.Passcode = "hashed"
+
+				userID = "6117e377b6e7bae09f52c483"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(createReplyError).To(Equal(application.NewForbiddenError("esta sala é privada.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the UpdateReply function", func() {
+		var roomID string
+		var userID string
+		var questionID string
+		var replyID string
+		var replyData dtos.UpdateReplyDTO
+		var result entities.Room
+		var updateReplyError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, updateReplyError = roomService.UpdateReply(userID, roomID, questionID, replyID, replyData)
+		})
+
+		When("the author edits their reply", func() {
+			var expectedUpdateReplyResult entities.Room
+
+			BeforeEach(func() {
+				updateReplyRequestSerialized, err := ioutil.ReadFile("../../../test/resources/update_reply_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionRepliedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_replied.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				replyData = dtos.UpdateReplyDTO{}
+				err = json.Unmarshal(updateReplyRequestSerialized, &replyData)
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionRepliedSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionRepliedSerialized, &expectedUpdateReplyResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedUpdateReplyResult.Questions[0].Replies[1].Content = *replyData.Content
+
+				userID = "621f5f40e07fdbb81c8221f8"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				replyID = "6220a402e07fdbb81c8221fb"
+
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
-				mockRoomRepository.EXPECT().Create(room).Return(expectedCreateResult, nil).Times(1)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().UpdateReply(roomID, questionID, gomock.AssignableToTypeOf(entities.Reply{})).Do(func(roomID string, questionID string, reply entities.Reply) {
+					Expect(reply.ID).To(Equal(replyID))
+					Expect(reply.Content).To(Equal(*replyData.Content))
+					Expect(reply.IsOfficial).To(BeFalse())
+					Expect(reply.UpdatedAt).NotTo(BeNil())
+				}).Return(expectedUpdateReplyResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.ReplyUpdatedEvent))
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
-			It("result should be equal to expected roomRepository.Create result", func() {
-				Expect(result).To(Equal(expectedCreateResult))
+			It("result should be equal to expected roomRepository.UpdateReply result", func() {
+				Expect(result).To(Equal(expectedUpdateReplyResult))
 			})
 
 			It("error should be nil", func() {
-				Expect(createError).Should(BeNil())
+				Expect(updateReplyError).Should(BeNil())
 			})
 
 			AfterEach(func() {
@@ -67,28 +5370,45 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("an error occurs while saving room in database", func() {
+		When("the room owner edits someone else's reply", func() {
 			BeforeEach(func() {
-				createRoomRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_room_request.json")
+				updateReplyRequestSerialized, err := ioutil.ReadFile("../../../test/resources/update_reply_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionRepliedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_replied.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				err = json.Unmarshal(createRoomRequestSerialized, &room)
+				replyData = dtos.UpdateReplyDTO{}
+				err = json.Unmarshal(updateReplyRequestSerialized, &replyData)
 				Expect(err).NotTo(HaveOccurred())
 
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionRepliedSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				replyID = "6220a402e07fdbb81c8221fb"
+
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
-				mockRoomRepository.EXPECT().Create(room).Return(entities.Room{}, errors.New("an error")).Times(1)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
 			It("result should be an empty room struct", func() {
 				Expect(result).To(Equal(entities.Room{}))
 			})
 
-			It("error should be the error returned by the roomRepository.Create function", func() {
-				Expect(createError).To(Equal(errors.New("an error")))
+			It("error should be a forbidden error", func() {
+				Expect(updateReplyError).To(Equal(application.NewForbiddenError("você não pode editar uma resposta que não é sua.")))
 			})
 
 			AfterEach(func() {
@@ -96,45 +5416,53 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-	})
-
-	Describe("Executing the FindByID function", func() {
-		var roomID string
-		var result entities.Room
-		var findByIDError error
-		var roomService services.RoomService
-		var mockCtrl *gomock.Controller
-
-		JustBeforeEach(func() {
-			result, findByIDError = roomService.FindByID(roomID)
-		})
-
-		When("the FindByID function is executed with success", func() {
-			var expectedFindByIDResult entities.Room
+		When("the room owner marks a reply as official", func() {
+			var expectedUpdateReplyResult entities.Room
 
 			BeforeEach(func() {
-				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
+				roomWithQuestionRepliedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_replied.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionRepliedSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionRepliedSerialized, &expectedUpdateReplyResult)
 				Expect(err).NotTo(HaveOccurred())
 
+				expectedUpdateReplyResult.Questions[0].Replies[1].IsOfficial = true
+
+				isOfficial := true
+				replyData = dtos.UpdateReplyDTO{IsOfficial: &isOfficial}
+
+				userID = "621f5e02e07fdbb81c8221f5"
 				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				replyID = "6220a402e07fdbb81c8221fb"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
 				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().UpdateReply(roomID, questionID, gomock.AssignableToTypeOf(entities.Reply{})).Do(func(roomID string, questionID string, reply entities.Reply) {
+					Expect(reply.Content).To(Equal("Eu ainda prefiro o Nokia E71."))
+					Expect(reply.IsOfficial).To(BeTrue())
+				}).Return(expectedUpdateReplyResult, nil).Times(1)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
-			It("result should be equal to expected roomRepository.FindByID result", func() {
-				Expect(result).To(Equal(expectedFindByIDResult))
+			It("result should be equal to expected roomRepository.UpdateReply result", func() {
+				Expect(result).To(Equal(expectedUpdateReplyResult))
 			})
 
 			It("error should be nil", func() {
-				Expect(findByIDError).Should(BeNil())
+				Expect(updateReplyError).Should(BeNil())
 			})
 
 			AfterEach(func() {
@@ -142,24 +5470,41 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("an error occurs while finding room by ID", func() {
+		When("the reply does not exist", func() {
 			BeforeEach(func() {
+				roomWithQuestionRepliedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_replied.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionRepliedSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				content := "Foi sim."
+				replyData = dtos.UpdateReplyDTO{Content: &content}
+
+				userID = "621f5f40e07fdbb81c8221f8"
 				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				replyID = "6220a402e07fdbb81c8221fc"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
-				mockRoomRepository.EXPECT().FindByID(roomID).Return(entities.Room{}, errors.New("an error")).Times(1)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
 			It("result should be an empty room struct", func() {
 				Expect(result).To(Equal(entities.Room{}))
 			})
 
-			It("error should be the error returned by the roomRepository.FindByID function", func() {
-				Expect(findByIDError).To(Equal(errors.New("an error")))
+			It("error should be a resource not found error", func() {
+				Expect(updateReplyError).To(Equal(domain.NewResourceNotFoundError("resposta não encontrada.")))
 			})
 
 			AfterEach(func() {
@@ -168,79 +5513,64 @@ var _ = Describe("Room", func() {
 		})
 	})
 
-	Describe("Executing the EndRoom function", func() {
+	Describe("Executing the DeleteReply function", func() {
 		var roomID string
 		var userID string
+		var questionID string
+		var replyID string
 		var result entities.Room
-		var endRoomError error
+		var deleteReplyError error
 		var roomService services.RoomService
 		var mockCtrl *gomock.Controller
 
 		JustBeforeEach(func() {
-			result, endRoomError = roomService.EndRoom(userID, roomID)
+			result, deleteReplyError = roomService.DeleteReply(userID, roomID, questionID, replyID)
 		})
 
-		When("the EndRoom function is executed with success", func() {
-			var expectedEndRoomResult entities.Room
+		When("the room owner deletes someone else's reply", func() {
+			var expectedDeleteReplyResult entities.Room
 
 			BeforeEach(func() {
-				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				roomWithQuestionRepliedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_replied.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				endedRoomSerialized, err := ioutil.ReadFile("../../../test/resources/ended_room.json")
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
 				Expect(err).NotTo(HaveOccurred())
 
 				var expectedFindByIDResult entities.Room
-				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				err = json.Unmarshal(roomWithQuestionRepliedSerialized, &expectedFindByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
-				err = json.Unmarshal(endedRoomSerialized, &expectedEndRoomResult)
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedDeleteReplyResult)
 				Expect(err).NotTo(HaveOccurred())
 
-				roomID = "621f5ec1e07fdbb81c8221f7"
 				userID = "621f5e02e07fdbb81c8221f5"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				replyID = "6220a402e07fdbb81c8221fb"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
 				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
-				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Return(expectedEndRoomResult, nil).Times(1)
-
-				roomService = services.NewRoomService(mockRoomRepository)
-			})
-
-			It("result should be equal to expected roomRepository.Update result", func() {
-				Expect(result).To(Equal(expectedEndRoomResult))
-			})
-
-			It("error should be nil", func() {
-				Expect(endRoomError).Should(BeNil())
-			})
-
-			AfterEach(func() {
-				mockCtrl.Finish()
-			})
-		})
-
-		When("an error occurs while finding room by ID", func() {
-			BeforeEach(func() {
-				roomID = "621f5ec1e07fdbb81c8221f7"
-				userID = "621f5e02e07fdbb81c8221f5"
+				mockRoomRepository.EXPECT().RemoveReply(roomID, questionID, replyID).Return(expectedDeleteReplyResult, nil).Times(1)
 
-				mockCtrl = gomock.NewController(GinkgoT())
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
 
-				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
-				mockRoomRepository.EXPECT().FindByID(roomID).Return(entities.Room{}, errors.New("an error")).Times(1)
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.ReplyDeletedEvent))
+				}).Times(1)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
-			It("result should be an empty room struct", func() {
-				Expect(result).To(Equal(entities.Room{}))
+			It("result should be equal to expected roomRepository.RemoveReply result", func() {
+				Expect(result).To(Equal(expectedDeleteReplyResult))
 			})
 
-			It("error should be the error returned by the roomRepository.FindByID function", func() {
-				Expect(endRoomError).To(Equal(errors.New("an error")))
+			It("error should be nil", func() {
+				Expect(deleteReplyError).Should(BeNil())
 			})
 
 			AfterEach(func() {
@@ -248,24 +5578,30 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("the userID is not equal to the room author ID", func() {
+		When("an attendee tries to delete someone else's reply", func() {
 			BeforeEach(func() {
-				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				roomWithQuestionRepliedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_replied.json")
 				Expect(err).NotTo(HaveOccurred())
 
 				var expectedFindByIDResult entities.Room
-				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				err = json.Unmarshal(roomWithQuestionRepliedSerialized, &expectedFindByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
+				userID = "621f5f40e07fdbb81c8221f8"
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				userID = "621f5e02e07fdbb81c8221l5"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				replyID = "6220a3d1e07fdbb81c8221fa"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
 				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
 			It("result should be an empty room struct", func() {
@@ -273,7 +5609,7 @@ var _ = Describe("Room", func() {
 			})
 
 			It("error should be a forbidden error", func() {
-				Expect(endRoomError).To(Equal(application.NewForbiddenError("você não pode encerrar uma sala que não é sua.")))
+				Expect(deleteReplyError).To(Equal(application.NewForbiddenError("você não pode remover uma resposta que não é sua.")))
 			})
 
 			AfterEach(func() {
@@ -281,33 +5617,39 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("an error occurs while updating room in database", func() {
+		When("an error occurs while removing the reply in database", func() {
 			BeforeEach(func() {
-				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				roomWithQuestionRepliedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_replied.json")
 				Expect(err).NotTo(HaveOccurred())
 
 				var expectedFindByIDResult entities.Room
-				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				err = json.Unmarshal(roomWithQuestionRepliedSerialized, &expectedFindByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
+				userID = "621f5f40e07fdbb81c8221f8"
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				userID = "621f5e02e07fdbb81c8221f5"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				replyID = "6220a402e07fdbb81c8221fb"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
 				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
-				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Return(entities.Room{}, errors.New("an error")).Times(1)
+				mockRoomRepository.EXPECT().RemoveReply(roomID, questionID, replyID).Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
 			It("result should be an empty room struct", func() {
 				Expect(result).To(Equal(entities.Room{}))
 			})
 
-			It("error should be the error returned by the roomRepository.Update function", func() {
-				Expect(endRoomError).To(Equal(errors.New("an error")))
+			It("error should be the error returned by the roomRepository.RemoveReply function", func() {
+				Expect(deleteReplyError).To(Equal(errors.New("an error")))
 			})
 
 			AfterEach(func() {
@@ -316,58 +5658,69 @@ var _ = Describe("Room", func() {
 		})
 	})
 
-	Describe("Executing the CreateQuestion function", func() {
+	Describe("Executing the CreatePoll function", func() {
 		var roomID string
-		var question entities.Question
+		var userID string
+		var pollDTO dtos.PollDTO
 		var result entities.Room
-		var createQuestionError error
+		var createPollError error
 		var roomService services.RoomService
 		var mockCtrl *gomock.Controller
 
 		JustBeforeEach(func() {
-			result, createQuestionError = roomService.CreateQuestion(roomID, question)
+			result, createPollError = roomService.CreatePoll(userID, roomID, pollDTO)
 		})
 
-		When("the CreateQuestion function is executed with success", func() {
-			var expectedCreateQuestionResult entities.Room
+		When("the room owner creates a poll", func() {
+			var expectedCreatePollResult entities.Room
 
 			BeforeEach(func() {
-				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_question_request.json")
+				createPollRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_poll_request.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				pollDTO = dtos.PollDTO{}
+				err = json.Unmarshal(createPollRequestSerialized, &pollDTO)
 				Expect(err).NotTo(HaveOccurred())
 
 				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				var expectedFindByIDResult entities.Room
-				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
+				var expectedFindSummaryByIDResult entities.Room
+				err = json.Unmarshal(roomSerialized, &expectedFindSummaryByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
-				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedCreateQuestionResult)
+				roomWithPollSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_poll.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				err = json.Unmarshal(roomWithPollSerialized, &expectedCreatePollResult)
 				Expect(err).NotTo(HaveOccurred())
 
+				userID = "621f5e02e07fdbb81c8221f5"
 				roomID = "621f5ec1e07fdbb81c8221f7"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
-				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
-				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Return(expectedCreateQuestionResult, nil).Times(1)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(expectedFindSummaryByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().PushPoll(roomID, gomock.AssignableToTypeOf(entities.Poll{})).Do(func(roomID string, poll entities.Poll) {
+					Expect(poll.Question).To(Equal(pollDTO.Question))
+					Expect(poll.Options).To(HaveLen(3))
+					Expect(poll.Status).To(Equal(entities.PollStatusDraft))
+				}).Return(expectedCreatePollResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
-			It("result should be equal to expected roomRepository.Update result", func() {
-				Expect(result).To(Equal(expectedCreateQuestionResult))
+			It("result should be equal to expected roomRepository.PushPoll result", func() {
+				Expect(result).To(Equal(expectedCreatePollResult))
 			})
 
 			It("error should be nil", func() {
-				Expect(createQuestionError).Should(BeNil())
+				Expect(createPollError).Should(BeNil())
 			})
 
 			AfterEach(func() {
@@ -375,84 +5728,38 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("an error occurs while finding room by ID", func() {
+		When("the user is only an attendee of the room", func() {
 			BeforeEach(func() {
-				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_question_request.json")
-				Expect(err).NotTo(HaveOccurred())
+				pollDTO = dtos.PollDTO{Question: "Qual foi o melhor celular com Symbian?", Options: []string{"Nokia N95", "Nokia E71"}}
 
 				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				var expectedFindByIDResult entities.Room
-				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
-				Expect(err).NotTo(HaveOccurred())
-
-				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				var expectedFindSummaryByIDResult entities.Room
+				err = json.Unmarshal(roomSerialized, &expectedFindSummaryByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
+				userID = "621f5f40e07fdbb81c8221f8"
 				roomID = "621f5ec1e07fdbb81c8221f7"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
-				mockRoomRepository.EXPECT().FindByID(roomID).Return(entities.Room{}, errors.New("an error")).Times(1)
-
-				roomService = services.NewRoomService(mockRoomRepository)
-			})
-
-			It("result should be an empty room struct", func() {
-				Expect(result).To(Equal(entities.Room{}))
-			})
-
-			It("error should be the error returned by the roomRepository.FindByID function", func() {
-				Expect(createQuestionError).To(Equal(errors.New("an error")))
-			})
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(expectedFindSummaryByIDResult, nil).Times(1)
 
-			AfterEach(func() {
-				mockCtrl.Finish()
-			})
-		})
-
-		When("an error occurs while updating room in database", func() {
-			var expectedUpdateResult entities.Room
-
-			BeforeEach(func() {
-				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_question_request.json")
-				Expect(err).NotTo(HaveOccurred())
-
-				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
-				Expect(err).NotTo(HaveOccurred())
-
-				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
-				Expect(err).NotTo(HaveOccurred())
-
-				var expectedFindByIDResult entities.Room
-				err = json.Unmarshal(roomSerialized, &expectedFindByIDResult)
-				Expect(err).NotTo(HaveOccurred())
-
-				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedUpdateResult)
-				Expect(err).NotTo(HaveOccurred())
-
-				err = json.Unmarshal(createQuestionRequestSerialized, &question)
-				Expect(err).NotTo(HaveOccurred())
-
-				roomID = "621f5ec1e07fdbb81c8221f7"
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
 
-				mockCtrl = gomock.NewController(GinkgoT())
-
-				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
-				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
-				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Return(entities.Room{}, errors.New("an error")).Times(1)
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
 			It("result should be an empty room struct", func() {
 				Expect(result).To(Equal(entities.Room{}))
 			})
 
-			It("error should be the error returned by the roomRepository.Update function", func() {
-				Expect(createQuestionError).To(Equal(errors.New("an error")))
+			It("error should be a forbidden error", func() {
+				Expect(createPollError).To(Equal(application.NewForbiddenError("você não tem permissão para gerenciar as enquetes desta sala.")))
 			})
 
 			AfterEach(func() {
@@ -461,62 +5768,64 @@ var _ = Describe("Room", func() {
 		})
 	})
 
-	Describe("Executing the UpdateQuestion function", func() {
-		var userID string
+	Describe("Executing the OpenPoll function", func() {
 		var roomID string
-		var questionID string
-		var questionData dtos.UpdateQuestionDTO
+		var userID string
+		var pollID string
 		var result entities.Room
-		var updateQuestionError error
+		var openPollError error
 		var roomService services.RoomService
 		var mockCtrl *gomock.Controller
 
 		JustBeforeEach(func() {
-			result, updateQuestionError = roomService.UpdateQuestion(userID, roomID, questionID, questionData)
+			result, openPollError = roomService.OpenPoll(userID, roomID, pollID)
 		})
 
-		When("the UpdateQuestion function is executed to highlight question with success", func() {
-			var expectedUpdateQuestionResult entities.Room
+		When("the room owner opens a draft poll", func() {
+			var expectedOpenPollResult entities.Room
 
 			BeforeEach(func() {
-				highlightQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/highlight_question_request.json")
-				Expect(err).NotTo(HaveOccurred())
-
-				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				roomWithPollSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_poll.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				roomWithQuestionHighlightedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_highlighted.json")
+				var expectedFindSummaryByIDResult entities.Room
+				err = json.Unmarshal(roomWithPollSerialized, &expectedFindSummaryByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
-				err = json.Unmarshal(roomWithQuestionHighlightedSerialized, &expectedUpdateQuestionResult)
-				Expect(err).NotTo(HaveOccurred())
+				expectedFindSummaryByIDResult.Polls[0].Status = entities.PollStatusDraft
 
-				err = json.Unmarshal(highlightQuestionRequestSerialized, &questionData)
+				roomWithPollSerialized, err = ioutil.ReadFile("../../../test/resources/room_with_poll.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				var expectedFindByIDResult entities.Room
-				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				err = json.Unmarshal(roomWithPollSerialized, &expectedOpenPollResult)
 				Expect(err).NotTo(HaveOccurred())
 
 				userID = "621f5e02e07fdbb81c8221f5"
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c8221f9"
+				pollID = "6221b7c4e07fdbb81c8221fd"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
-				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
-				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Return(expectedUpdateQuestionResult, nil).Times(1)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(expectedFindSummaryByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().SetPollStatus(roomID, pollID, entities.PollStatusOpen).Return(expectedOpenPollResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.PollOpenedEvent))
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
-			It("result should be equal to expected roomRepository.Update result", func() {
-				Expect(result).To(Equal(expectedUpdateQuestionResult))
+			It("result should be equal to expected roomRepository.SetPollStatus result", func() {
+				Expect(result).To(Equal(expectedOpenPollResult))
 			})
 
 			It("error should be nil", func() {
-				Expect(updateQuestionError).Should(BeNil())
+				Expect(openPollError).Should(BeNil())
 			})
 
 			AfterEach(func() {
@@ -524,48 +5833,37 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("the UpdateQuestion function is executed to mark question as answered with success", func() {
-			var expectedUpdateQuestionResult entities.Room
-
+		When("the poll is already open", func() {
 			BeforeEach(func() {
-				markQuestionAsAnsweredRequestSerialized, err := ioutil.ReadFile("../../../test/resources/mark_question_as_answered_request.json")
-				Expect(err).NotTo(HaveOccurred())
-
-				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
-				Expect(err).NotTo(HaveOccurred())
-
-				roomWithQuestionAnsweredSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_answered.json")
+				roomWithPollSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_poll.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				err = json.Unmarshal(roomWithQuestionAnsweredSerialized, &expectedUpdateQuestionResult)
-				Expect(err).NotTo(HaveOccurred())
-
-				err = json.Unmarshal(markQuestionAsAnsweredRequestSerialized, &questionData)
-				Expect(err).NotTo(HaveOccurred())
-
-				var expectedFindByIDResult entities.Room
-				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				var expectedFindSummaryByIDResult entities.Room
+				err = json.Unmarshal(roomWithPollSerialized, &expectedFindSummaryByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
 				userID = "621f5e02e07fdbb81c8221f5"
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c8221f9"
+				pollID = "6221b7c4e07fdbb81c8221fd"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
-				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
-				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Return(expectedUpdateQuestionResult, nil).Times(1)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(expectedFindSummaryByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
-			It("result should be equal to expected roomRepository.Update result", func() {
-				Expect(result).To(Equal(expectedUpdateQuestionResult))
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
 			})
 
-			It("error should be nil", func() {
-				Expect(updateQuestionError).Should(BeNil())
+			It("error should be a conflict error", func() {
+				Expect(openPollError).To(Equal(domain.NewConflictError("a enquete já está aberta.")))
 			})
 
 			AfterEach(func() {
@@ -573,72 +5871,100 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("an error occurs while finding room by ID", func() {
+		When("the poll does not exist", func() {
 			BeforeEach(func() {
-				markQuestionAsAnsweredRequestSerialized, err := ioutil.ReadFile("../../../test/resources/mark_question_as_answered_request.json")
+				roomWithPollSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_poll.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				err = json.Unmarshal(markQuestionAsAnsweredRequestSerialized, &questionData)
+				var expectedFindSummaryByIDResult entities.Room
+				err = json.Unmarshal(roomWithPollSerialized, &expectedFindSummaryByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
 				userID = "621f5e02e07fdbb81c8221f5"
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c8221f9"
+				pollID = "6221b7c4e07fdbb81c822201"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
-				mockRoomRepository.EXPECT().FindByID(roomID).Return(entities.Room{}, errors.New("an error")).Times(1)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(expectedFindSummaryByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
 			It("result should be an empty room struct", func() {
 				Expect(result).To(Equal(entities.Room{}))
 			})
 
-			It("error should be the error returned by the roomRepository.FindByID function", func() {
-				Expect(updateQuestionError).To(Equal(errors.New("an error")))
+			It("error should be a resource not found error", func() {
+				Expect(openPollError).To(Equal(domain.NewResourceNotFoundError("enquete não encontrada.")))
 			})
 
 			AfterEach(func() {
 				mockCtrl.Finish()
 			})
 		})
+	})
+
+	Describe("Executing the ClosePoll function", func() {
+		var roomID string
+		var userID string
+		var pollID string
+		var result entities.Room
+		var closePollError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, closePollError = roomService.ClosePoll(userID, roomID, pollID)
+		})
+
+		When("the room owner closes an open poll", func() {
+			var expectedClosePollResult entities.Room
 
-		When("the userID is not equal to the room author ID", func() {
 			BeforeEach(func() {
-				markQuestionAsAnsweredRequestSerialized, err := ioutil.ReadFile("../../../test/resources/mark_question_as_answered_request.json")
+				roomWithPollSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_poll.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				var expectedFindSummaryByIDResult entities.Room
+				err = json.Unmarshal(roomWithPollSerialized, &expectedFindSummaryByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
-				err = json.Unmarshal(markQuestionAsAnsweredRequestSerialized, &questionData)
+				err = json.Unmarshal(roomWithPollSerialized, &expectedClosePollResult)
 				Expect(err).NotTo(HaveOccurred())
 
-				var expectedFindByIDResult entities.Room
-				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
-				Expect(err).NotTo(HaveOccurred())
+				expectedClosePollResult.Polls[0].Status = entities.PollStatusClosed
 
-				userID = "621f5e02e07fdbb81c8221e5"
+				userID = "621f5e02e07fdbb81c8221f5"
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c8221f9"
+				pollID = "6221b7c4e07fdbb81c8221fd"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
-				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(expectedFindSummaryByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().SetPollStatus(roomID, pollID, entities.PollStatusClosed).Return(expectedClosePollResult, nil).Times(1)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.PollClosedEvent))
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
-			It("result should be an empty room struct", func() {
-				Expect(result).To(Equal(entities.Room{}))
+			It("result should be equal to expected roomRepository.SetPollStatus result", func() {
+				Expect(result).To(Equal(expectedClosePollResult))
 			})
 
-			It("error should be a forbidden error", func() {
-				Expect(updateQuestionError).To(Equal(application.NewForbiddenError("você não pode atualizar informações de uma sala que não é sua.")))
+			It("error should be nil", func() {
+				Expect(closePollError).Should(BeNil())
 			})
 
 			AfterEach(func() {
@@ -646,40 +5972,39 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("an error occurs while updating room in database", func() {
+		When("the poll is still a draft", func() {
 			BeforeEach(func() {
-				markQuestionAsAnsweredRequestSerialized, err := ioutil.ReadFile("../../../test/resources/mark_question_as_answered_request.json")
+				roomWithPollSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_poll.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
-				Expect(err).NotTo(HaveOccurred())
-
-				err = json.Unmarshal(markQuestionAsAnsweredRequestSerialized, &questionData)
+				var expectedFindSummaryByIDResult entities.Room
+				err = json.Unmarshal(roomWithPollSerialized, &expectedFindSummaryByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
-				var expectedFindByIDResult entities.Room
-				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
-				Expect(err).NotTo(HaveOccurred())
+				expectedFindSummaryByIDResult.Polls[0].Status = entities.PollStatusDraft
 
 				userID = "621f5e02e07fdbb81c8221f5"
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c8221f9"
+				pollID = "6221b7c4e07fdbb81c8221fd"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
-				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
-				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Return(entities.Room{}, errors.New("an error")).Times(1)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(expectedFindSummaryByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
 			It("result should be an empty room struct", func() {
 				Expect(result).To(Equal(entities.Room{}))
 			})
 
-			It("error should be the error returned by the roomRepository.Update function", func() {
-				Expect(updateQuestionError).To(Equal(errors.New("an error")))
+			It("error should be a conflict error", func() {
+				Expect(closePollError).To(Equal(domain.NewConflictError("a enquete não está aberta.")))
 			})
 
 			AfterEach(func() {
@@ -688,60 +6013,73 @@ var _ = Describe("Room", func() {
 		})
 	})
 
-	Describe("Executing the LikeQuestion function", func() {
+	Describe("Executing the Vote function", func() {
 		var roomID string
-		var questionID string
-		var like entities.Like
+		var userID string
+		var pollID string
+		var voteDTO dtos.VoteDTO
 		var result entities.Room
-		var likeQuestionError error
+		var voteError error
 		var roomService services.RoomService
 		var mockCtrl *gomock.Controller
 
 		JustBeforeEach(func() {
-			result, likeQuestionError = roomService.LikeQuestion(roomID, questionID, like)
+			result, voteError = roomService.Vote(userID, roomID, pollID, voteDTO, "")
 		})
 
-		When("the LikeQuestion function is executed with success", func() {
-			var expectedLikeQuestionResult entities.Room
+		When("an attendee votes on an open poll", func() {
+			var expectedVoteResult entities.Room
 
 			BeforeEach(func() {
-				likeQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/like_question_request.json")
+				voteRequestSerialized, err := ioutil.ReadFile("../../../test/resources/vote_request.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				voteDTO = dtos.VoteDTO{}
+				err = json.Unmarshal(voteRequestSerialized, &voteDTO)
 				Expect(err).NotTo(HaveOccurred())
 
-				roomWithQuestionLikedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_liked.json")
+				roomWithPollSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_poll.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				var expectedFindByIDResult entities.Room
-				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				var expectedFindSummaryByIDResult entities.Room
+				err = json.Unmarshal(roomWithPollSerialized, &expectedFindSummaryByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
-				err = json.Unmarshal(roomWithQuestionLikedSerialized, &expectedLikeQuestionResult)
+				err = json.Unmarshal(roomWithPollSerialized, &expectedVoteResult)
 				Expect(err).NotTo(HaveOccurred())
 
-				err = json.Unmarshal(likeQuestionRequestSerialized, &like)
-				Expect(err).NotTo(HaveOccurred())
+				expectedVoteResult.Polls[0].Options[0].VoteCount = 1
+				expectedVoteResult.Polls[0].TotalVotes = 1
 
+				userID = "621f5f40e07fdbb81c8221f8"
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c8221f9"
+				pollID = "6221b7c4e07fdbb81c8221fd"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
-				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
-				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Return(expectedLikeQuestionResult, nil).Times(1)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(expectedFindSummaryByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().AddVote(roomID, pollID, gomock.AssignableToTypeOf(entities.PollVote{})).Do(func(roomID string, pollID string, vote entities.PollVote) {
+					Expect(vote.UserID).To(Equal(userID))
+					Expect(vote.OptionIDs).To(Equal(voteDTO.OptionIDs))
+				}).Return(expectedVoteResult, nil).Times(1)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.PollVotedEvent))
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
-			It("result should be equal to expected roomRepository.Update result", func() {
-				Expect(result).To(Equal(expectedLikeQuestionResult))
+			It("result should be equal to expected roomRepository.AddVote result", func() {
+				Expect(result).To(Equal(expectedVoteResult))
 			})
 
 			It("error should be nil", func() {
-				Expect(likeQuestionError).Should(BeNil())
+				Expect(voteError).Should(BeNil())
 			})
 
 			AfterEach(func() {
@@ -749,31 +6087,43 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("an error occurs while finding room by ID", func() {
+		When("the user has already voted", func() {
 			BeforeEach(func() {
-				likeQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/like_question_request.json")
+				roomWithPollSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_poll.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				err = json.Unmarshal(likeQuestionRequestSerialized, &like)
+				var expectedFindSummaryByIDResult entities.Room
+				err = json.Unmarshal(roomWithPollSerialized, &expectedFindSummaryByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
+				expectedFindSummaryByIDResult.Polls[0].Votes = []entities.PollVote{
+					{UserID: "621f5f40e07fdbb81c8221f8", OptionIDs: []string{"6221b7c4e07fdbb81c8221ff"}},
+				}
+
+				voteDTO = dtos.VoteDTO{OptionIDs: []string{"6221b7c4e07fdbb81c8221fe"}}
+
+				userID = "621f5f40e07fdbb81c8221f8"
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c8221f9"
+				pollID = "6221b7c4e07fdbb81c8221fd"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
-				mockRoomRepository.EXPECT().FindByID(roomID).Return(entities.Room{}, errors.New("an error")).Times(1)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(expectedFindSummaryByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
 			It("result should be an empty room struct", func() {
 				Expect(result).To(Equal(entities.Room{}))
 			})
 
-			It("error should be the error returned by the roomRepository.FindByID function", func() {
-				Expect(likeQuestionError).To(Equal(errors.New("an error")))
+			It("error should be a conflict error", func() {
+				Expect(voteError).To(Equal(domain.NewConflictError("você já votou nesta enquete.")))
 			})
 
 			AfterEach(func() {
@@ -781,38 +6131,81 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("try to like question that does not exists", func() {
+		When("several options are chosen on a single choice poll", func() {
 			BeforeEach(func() {
-				likeQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/like_question_request.json")
+				roomWithPollSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_poll.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				var expectedFindSummaryByIDResult entities.Room
+				err = json.Unmarshal(roomWithPollSerialized, &expectedFindSummaryByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
-				var expectedFindByIDResult entities.Room
-				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				voteDTO = dtos.VoteDTO{OptionIDs: []string{"6221b7c4e07fdbb81c8221fe", "6221b7c4e07fdbb81c8221ff"}}
+
+				userID = "621f5f40e07fdbb81c8221f8"
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				pollID = "6221b7c4e07fdbb81c8221fd"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(expectedFindSummaryByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
+			})
+
+			It("result should be an empty room struct", func() {
+				Expect(result).To(Equal(entities.Room{}))
+			})
+
+			It("error should be a bad request error", func() {
+				Expect(voteError).To(Equal(domain.NewBadRequestError("esta enquete aceita somente uma opção.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the poll is closed", func() {
+			BeforeEach(func() {
+				roomWithPollSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_poll.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				err = json.Unmarshal(likeQuestionRequestSerialized, &like)
+				var expectedFindSummaryByIDResult entities.Room
+				err = json.Unmarshal(roomWithPollSerialized, &expectedFindSummaryByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
+				expectedFindSummaryByIDResult.Polls[0].Status = entities.PollStatusClosed
+
+				voteDTO = dtos.VoteDTO{OptionIDs: []string{"6221b7c4e07fdbb81c8221fe"}}
+
+				userID = "621f5f40e07fdbb81c8221f8"
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c82213f9"
+				pollID = "6221b7c4e07fdbb81c8221fd"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
-				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(expectedFindSummaryByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
 			It("result should be an empty room struct", func() {
 				Expect(result).To(Equal(entities.Room{}))
 			})
 
-			It("error should a ResourceNotFoundError", func() {
-				Expect(likeQuestionError).To(Equal(domain.NewResourceNotFoundError("pergunta não encontrada.")))
+			It("error should be a conflict error", func() {
+				Expect(voteError).To(Equal(domain.NewConflictError("a enquete não está aberta para votação.")))
 			})
 
 			AfterEach(func() {
@@ -820,39 +6213,47 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("an error occurs while updating room in database", func() {
+		When("the room is private and the user has no grant", func() {
 			BeforeEach(func() {
-				likeQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/like_question_request.json")
+				voteRequestSerialized, err := ioutil.ReadFile("../../../test/resources/vote_request.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				voteDTO = dtos.VoteDTO{}
+				err = json.Unmarshal(voteRequestSerialized, &voteDTO)
 				Expect(err).NotTo(HaveOccurred())
 
-				var expectedFindByIDResult entities.Room
-				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				roomWithPollSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_poll.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				err = json.Unmarshal(likeQuestionRequestSerialized, &like)
+				var expectedFindSummaryByIDResult entities.Room
+				err = json.Unmarshal(roomWithPollSerialized, &expectedFindSummaryByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
+				expectedFindSummaryByIDResult.Settings.Visibility = entities.RoomVisibilityPasscode
+				expectedFindSummaryByIDResult.Settings.Passcode = "hashed"
+
+				userID = "621f5f40e07fdbb81c8221f8"
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c8221f9"
+				pollID = "6221b7c4e07fdbb81c8221fd"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
-				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
-				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Return(entities.Room{}, errors.New("an error")).Times(1)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(expectedFindSummaryByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
 			It("result should be an empty room struct", func() {
 				Expect(result).To(Equal(entities.Room{}))
 			})
 
-			It("error should be the error returned by the roomRepository.Update function", func() {
-				Expect(likeQuestionError).To(Equal(errors.New("an error")))
+			It("error should be a forbidden error", func() {
+				Expect(voteError).To(Equal(application.NewForbiddenError("esta sala é privada.")))
 			})
 
 			AfterEach(func() {
@@ -861,55 +6262,77 @@ var _ = Describe("Room", func() {
 		})
 	})
 
-	Describe("Executing the DeslikeQuestion function", func() {
+	Describe("Executing the EditQuestion function", func() {
 		var roomID string
+		var userID string
 		var questionID string
-		var likeID string
+		var questionDTO dtos.EditQuestionDTO
 		var result entities.Room
-		var deslikeQuestionError error
+		var editQuestionError error
 		var roomService services.RoomService
 		var mockCtrl *gomock.Controller
 
 		JustBeforeEach(func() {
-			result, deslikeQuestionError = roomService.DeslikeQuestion(roomID, questionID, likeID)
+			result, editQuestionError = roomService.EditQuestion(userID, roomID, questionID, questionDTO)
 		})
 
-		When("the DeslikeQuestion function is executed with success", func() {
-			var expectedDeslikeQuestionResult entities.Room
+		When("the author edits the question within the edit window", func() {
+			var expectedEditQuestionResult entities.Room
 
 			BeforeEach(func() {
-				roomWithQuestionLikedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_liked.json")
+				editQuestionRequestSerialized, err := ioutil.ReadFile("../../../test/resources/edit_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(editQuestionRequestSerialized, &questionDTO)
 				Expect(err).NotTo(HaveOccurred())
 
 				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
 				Expect(err).NotTo(HaveOccurred())
 
 				var expectedFindByIDResult entities.Room
-				err = json.Unmarshal(roomWithQuestionLikedSerialized, &expectedFindByIDResult)
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
-				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedDeslikeQuestionResult)
+				expectedFindByIDResult.Questions[0].CreatedAt = time.Now().Add(-time.Minute)
+				originalContent := expectedFindByIDResult.Questions[0].Content
+
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedEditQuestionResult)
 				Expect(err).NotTo(HaveOccurred())
 
+				expectedEditQuestionResult.Questions[0].Content = questionDTO.Content
+
+				userID = "621f5f40e07fdbb81c8221f8"
 				roomID = "621f5ec1e07fdbb81c8221f7"
 				questionID = "621f5f94e07fdbb81c8221f9"
-				likeID = "6176903081f4f3f262acd6b4"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
 				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
-				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Return(expectedDeslikeQuestionResult, nil).Times(1)
-
-				roomService = services.NewRoomService(mockRoomRepository)
+				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Do(func(roomID string, room entities.Room) {
+					question := room.Questions[0]
+					Expect(question.Content).To(Equal(questionDTO.Content))
+					Expect(question.EditedAt).NotTo(BeNil())
+					Expect(question.Revisions).To(HaveLen(1))
+					Expect(question.Revisions[0].Content).To(Equal(originalContent))
+				}).Return(expectedEditQuestionResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Publish(gomock.AssignableToTypeOf(dtos.RoomEventDTO{})).Do(func(event dtos.RoomEventDTO) {
+					Expect(event.Type).To(Equal(dtos.QuestionEditedEvent))
+				}).Times(1)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
 			It("result should be equal to expected roomRepository.Update result", func() {
-				Expect(result).To(Equal(expectedDeslikeQuestionResult))
+				Expect(result).To(Equal(expectedEditQuestionResult))
 			})
 
 			It("error should be nil", func() {
-				Expect(deslikeQuestionError).Should(BeNil())
+				Expect(editQuestionError).Should(BeNil())
 			})
 
 			AfterEach(func() {
@@ -917,26 +6340,41 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("an error occurs while finding room by ID", func() {
+		When("someone else tries to edit the question", func() {
 			BeforeEach(func() {
+				questionDTO = dtos.EditQuestionDTO{Content: "O Nokia N95 foi o melhor celular com Symbian?"}
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var expectedFindByIDResult entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedFindByIDResult.Questions[0].CreatedAt = time.Now()
+
+				userID = "621f5e02e07fdbb81c8221f5"
 				roomID = "621f5ec1e07fdbb81c8221f7"
 				questionID = "621f5f94e07fdbb81c8221f9"
-				likeID = "6176903081f4f3f262acd6b4"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
-				mockRoomRepository.EXPECT().FindByID(roomID).Return(entities.Room{}, errors.New("an error")).Times(1)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
 			It("result should be an empty room struct", func() {
 				Expect(result).To(Equal(entities.Room{}))
 			})
 
-			It("error should be the error returned by the roomRepository.FindByID function", func() {
-				Expect(deslikeQuestionError).To(Equal(errors.New("an error")))
+			It("error should be a forbidden error", func() {
+				Expect(editQuestionError).To(Equal(application.NewForbiddenError("você não pode editar uma pergunta que não é sua.")))
 			})
 
 			AfterEach(func() {
@@ -944,33 +6382,39 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("try to deslike question that does not exists", func() {
+		When("the edit window is over", func() {
 			BeforeEach(func() {
-				roomWithQuestionLikedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_liked.json")
+				questionDTO = dtos.EditQuestionDTO{Content: "O Nokia N95 foi o melhor celular com Symbian?"}
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
 				Expect(err).NotTo(HaveOccurred())
 
 				var expectedFindByIDResult entities.Room
-				err = json.Unmarshal(roomWithQuestionLikedSerialized, &expectedFindByIDResult)
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
+				userID = "621f5f40e07fdbb81c8221f8"
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c8421f9"
-				likeID = "6176903081f4f3f262acd6b4"
+				questionID = "621f5f94e07fdbb81c8221f9"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
 				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
 			It("result should be an empty room struct", func() {
 				Expect(result).To(Equal(entities.Room{}))
 			})
 
-			It("error should a ResourceNotFoundError", func() {
-				Expect(deslikeQuestionError).To(Equal(domain.NewResourceNotFoundError("pergunta não encontrada.")))
+			It("error should be a forbidden error", func() {
+				Expect(editQuestionError).To(Equal(application.NewForbiddenError("o prazo para editar esta pergunta já terminou.")))
 			})
 
 			AfterEach(func() {
@@ -978,34 +6422,42 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("an error occurs while updating room in database", func() {
+		When("the question was already answered", func() {
 			BeforeEach(func() {
-				roomWithQuestionLikedSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_question_liked.json")
+				questionDTO = dtos.EditQuestionDTO{Content: "O Nokia N95 foi o melhor celular com Symbian?"}
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
 				Expect(err).NotTo(HaveOccurred())
 
 				var expectedFindByIDResult entities.Room
-				err = json.Unmarshal(roomWithQuestionLikedSerialized, &expectedFindByIDResult)
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
+				expectedFindByIDResult.Questions[0].CreatedAt = time.Now()
+				expectedFindByIDResult.Questions[0].IsAnswered = true
+
+				userID = "621f5f40e07fdbb81c8221f8"
 				roomID = "621f5ec1e07fdbb81c8221f7"
 				questionID = "621f5f94e07fdbb81c8221f9"
-				likeID = "6176903081f4f3f262acd6b4"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
 				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
-				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Return(entities.Room{}, errors.New("an error")).Times(1)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
 			It("result should be an empty room struct", func() {
 				Expect(result).To(Equal(entities.Room{}))
 			})
 
-			It("error should be the error returned by the roomRepository.Update function", func() {
-				Expect(deslikeQuestionError).To(Equal(errors.New("an error")))
+			It("error should be a conflict error", func() {
+				Expect(editQuestionError).To(Equal(domain.NewConflictError("uma pergunta já respondida não pode ser editada.")))
 			})
 
 			AfterEach(func() {
@@ -1014,55 +6466,57 @@ var _ = Describe("Room", func() {
 		})
 	})
 
-	Describe("Executing the DeleteQuestion function", func() {
+	Describe("Executing the FindQuestionRevisions function", func() {
 		var roomID string
 		var userID string
 		var questionID string
-		var result entities.Room
-		var deleteQuestionError error
+		var result []entities.QuestionRevision
+		var findQuestionRevisionsError error
 		var roomService services.RoomService
 		var mockCtrl *gomock.Controller
 
 		JustBeforeEach(func() {
-			result, deleteQuestionError = roomService.DeleteQuestion(userID, roomID, questionID)
+			result, findQuestionRevisionsError = roomService.FindQuestionRevisions(userID, roomID, questionID)
 		})
 
-		When("the DeleteQuestion function is executed with success", func() {
-			var expectedDeleteQuestionResult entities.Room
+		When("the room owner looks at the edit history", func() {
+			var revisions []entities.QuestionRevision
 
 			BeforeEach(func() {
 				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room.json")
-				Expect(err).NotTo(HaveOccurred())
-
-				err = json.Unmarshal(roomSerialized, &expectedDeleteQuestionResult)
-				Expect(err).NotTo(HaveOccurred())
-
 				var expectedFindByIDResult entities.Room
 				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
+				revisions = []entities.QuestionRevision{
+					{Content: "O Nokia N95 foi o melhor celular?", CreatedAt: expectedFindByIDResult.Questions[0].CreatedAt},
+				}
+				expectedFindByIDResult.Questions[0].Revisions = revisions
+
+				userID = "621f5e02e07fdbb81c8221f5"
 				roomID = "621f5ec1e07fdbb81c8221f7"
 				questionID = "621f5f94e07fdbb81c8221f9"
-				userID = "621f5e02e07fdbb81c8221f5"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
 				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
-				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Return(expectedDeleteQuestionResult, nil).Times(1)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
-			It("result should be equal to expected roomRepository.Update result", func() {
-				Expect(result).To(Equal(expectedDeleteQuestionResult))
+			It("result should be the revisions of the question", func() {
+				Expect(result).To(Equal(revisions))
 			})
 
 			It("error should be nil", func() {
-				Expect(deleteQuestionError).Should(BeNil())
+				Expect(findQuestionRevisionsError).Should(BeNil())
 			})
 
 			AfterEach(func() {
@@ -1070,7 +6524,7 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("an error occurs while finding room by ID", func() {
+		When("the user is only an attendee of the room", func() {
 			BeforeEach(func() {
 				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
 				Expect(err).NotTo(HaveOccurred())
@@ -1079,58 +6533,88 @@ var _ = Describe("Room", func() {
 				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
+				userID = "621f5f40e07fdbb81c8221f8"
 				roomID = "621f5ec1e07fdbb81c8221f7"
 				questionID = "621f5f94e07fdbb81c8221f9"
-				userID = "621f5e02e07fdbb81c8221f5"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
-				mockRoomRepository.EXPECT().FindByID(roomID).Return(entities.Room{}, errors.New("an error")).Times(1)
+				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
-			It("result should be an empty room struct", func() {
-				Expect(result).To(Equal(entities.Room{}))
+			It("result should be empty", func() {
+				Expect(result).To(BeEmpty())
 			})
 
-			It("error should be the error returned by the roomRepository.FindByID function", func() {
-				Expect(deleteQuestionError).To(Equal(errors.New("an error")))
+			It("error should be a forbidden error", func() {
+				Expect(findQuestionRevisionsError).To(Equal(application.NewForbiddenError("você não tem permissão para ver o histórico de edições das perguntas desta sala.")))
 			})
 
 			AfterEach(func() {
 				mockCtrl.Finish()
 			})
 		})
+	})
+
+	Describe("Executing the FindDeletedQuestions function", func() {
+		var userID string
+		var roomID string
+		var query dtos.QuestionQueryDTO
+		var result dtos.QuestionPageDTO
+		var findDeletedQuestionsError error
+		var roomService services.RoomService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, findDeletedQuestionsError = roomService.FindDeletedQuestions(userID, roomID, query)
+		})
+
+		When("the room owner lists the deleted questions", func() {
+			var room entities.Room
 
-		When("the userID is not equal to the room author ID", func() {
 			BeforeEach(func() {
-				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				var expectedFindByIDResult entities.Room
-				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				err = json.Unmarshal(roomSerialized, &room)
 				Expect(err).NotTo(HaveOccurred())
 
+				userID = "621f5e02e07fdbb81c8221f5"
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c8221f9"
-				userID = "621f5e02e07fdbb81e8221f5"
+				query = dtos.QuestionQueryDTO{}
+
+				expectedFilter := repositories.QuestionFilter{
+					Order:   repositories.QuestionOrderNewest,
+					Deleted: true,
+					Limit:   20,
+				}
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
-				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(room, nil).Times(1)
+				mockRoomRepository.EXPECT().FindQuestions(roomID, expectedFilter).Return(repositories.QuestionPage{Questions: room.Questions}, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
-			It("result should be an empty room struct", func() {
-				Expect(result).To(Equal(entities.Room{}))
+			It("result should contain the deleted questions", func() {
+				Expect(result).To(Equal(dtos.QuestionPageDTO{Questions: room.Questions}))
 			})
 
-			It("error should be a forbidden error", func() {
-				Expect(deleteQuestionError).To(Equal(application.NewForbiddenError("você não pode remover uma pergunta de uma sala que não é sua.")))
+			It("error should be nil", func() {
+				Expect(findDeletedQuestionsError).Should(BeNil())
 			})
 
 			AfterEach(func() {
@@ -1138,34 +6622,37 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("an error occurs while updating room in database", func() {
+		When("the user is only an attendee of the room", func() {
 			BeforeEach(func() {
-				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
+				roomSerialized, err := ioutil.ReadFile("../../../test/resources/room_with_questions.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				var expectedFindByIDResult entities.Room
-				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				var room entities.Room
+				err = json.Unmarshal(roomSerialized, &room)
 				Expect(err).NotTo(HaveOccurred())
 
+				userID = "6117e377b6e7bae09f52c483"
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c8221f9"
-				userID = "621f5e02e07fdbb81c8221f5"
+				query = dtos.QuestionQueryDTO{}
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockRoomRepository := repositoriesMocks.NewMockRoomRepository(mockCtrl)
-				mockRoomRepository.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
-				mockRoomRepository.EXPECT().Update(roomID, gomock.AssignableToTypeOf(entities.Room{})).Return(entities.Room{}, errors.New("an error")).Times(1)
+				mockRoomRepository.EXPECT().FindSummaryByID(roomID).Return(room, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
 
-				roomService = services.NewRoomService(mockRoomRepository)
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				roomService = services.NewRoomService(mockRoomRepository, mockUserRepository, securityMocks.NewMockSecurityProvider(mockCtrl), securityMocks.NewMockSigner(mockCtrl), mockBroadcaster, roomPolicy, roomConfig)
 			})
 
-			It("result should be an empty room struct", func() {
-				Expect(result).To(Equal(entities.Room{}))
+			It("result should be an empty page", func() {
+				Expect(result).To(Equal(dtos.QuestionPageDTO{}))
 			})
 
-			It("error should be the error returned by the roomRepository.Update function", func() {
-				Expect(deleteQuestionError).To(Equal(errors.New("an error")))
+			It("error should be a forbidden error", func() {
+				Expect(findDeletedQuestionsError).To(Equal(application.NewForbiddenError("você não tem permissão para ver as perguntas removidas desta sala.")))
 			})
 
 			AfterEach(func() {
@@ -1,10 +1,19 @@
 package services
 
 import (
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
 	"github.com/waliqueiroz/letmeask-api/internal/application/dtos"
-	"github.com/waliqueiroz/letmeask-api/internal/application/errors"
+	application "github.com/waliqueiroz/letmeask-api/internal/application/errors"
+	"github.com/waliqueiroz/letmeask-api/internal/application/policies"
 	"github.com/waliqueiroz/letmeask-api/internal/application/providers"
 	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+	domain "github.com/waliqueiroz/letmeask-api/internal/domain/errors"
 	"github.com/waliqueiroz/letmeask-api/internal/domain/repositories"
 )
 
@@ -12,20 +21,35 @@ type UserService interface {
 	FindAll() ([]entities.User, error)
 	Create(user entities.User) (entities.User, error)
 	FindByID(userID string) (entities.User, error)
-	Update(userID string, userDTO dtos.UserDTO) (entities.User, error)
-	Delete(userID string) error
-	UpdatePassword(userID string, password dtos.PasswordDTO) error
+	Update(actingUserID, userID string, userDTO dtos.UserDTO) (entities.User, error)
+	Delete(actingUserID, userID string) error
+	UpdatePassword(actingUserID, userID string, password dtos.PasswordDTO) error
+	VerifyEmail(token string) (entities.User, error)
+	ResendVerification(userID string) error
+}
+
+type UserConfig struct {
+	VerificationTTL time.Duration
+	VerificationURL string
 }
 
 type userService struct {
 	userRepository   repositories.UserRepository
 	securityProvider providers.SecurityProvider
+	userPolicy       policies.UserPolicy
+	signer           providers.Signer
+	mailer           providers.Mailer
+	config           UserConfig
 }
 
-func NewUserService(userRepository repositories.UserRepository, securityProvider providers.SecurityProvider) *userService {
+func NewUserService(userRepository repositories.UserRepository, securityProvider providers.SecurityProvider, userPolicy policies.UserPolicy, signer providers.Signer, mailer providers.Mailer, config UserConfig) *userService {
 	return &userService{
 		userRepository,
 		securityProvider,
+		userPolicy,
+		signer,
+		mailer,
+		config,
 	}
 }
 
@@ -40,36 +64,85 @@ func (service *userService) Create(user entities.User) (entities.User, error) {
 	}
 
 	user.Password = string(hashedPassword)
+	user.Role = entities.UserRoleUser
+	user.VerifiedAt = nil
+
+	user, err = service.userRepository.Create(user)
+	if err != nil {
+		return entities.User{}, err
+	}
+
+	// The account already exists, so failing here would only make the client
+	// sign up again. The link can be asked for again once the mail is back.
+	if err := service.sendVerification(user); err != nil {
+		log.Println(err)
+	}
 
-	return service.userRepository.Create(user)
+	return user, nil
 }
 
 func (service *userService) FindByID(userID string) (entities.User, error) {
 	return service.userRepository.FindByID(userID)
 }
 
-func (service *userService) Update(userID string, userDTO dtos.UserDTO) (entities.User, error) {
+func (service *userService) Update(actingUserID, userID string, userDTO dtos.UserDTO) (entities.User, error) {
+	if err := service.authorize(actingUserID, userID); err != nil {
+		return entities.User{}, err
+	}
+
+	current, err := service.userRepository.FindByID(userID)
+	if err != nil {
+		return entities.User{}, err
+	}
+
 	user := entities.User{
-		Name:   userDTO.Name,
-		Email:  userDTO.Email,
-		Avatar: userDTO.Avatar,
+		Name:       userDTO.Name,
+		Email:      userDTO.Email,
+		Avatar:     userDTO.Avatar,
+		VerifiedAt: current.VerifiedAt,
+	}
+
+	// Verifying the old address says nothing about the new one, which has to
+	// be verified on its own.
+	emailChanged := current.Email != userDTO.Email
+	if emailChanged {
+		user.VerifiedAt = nil
 	}
 
-	return service.userRepository.Update(userID, user)
+	user, err = service.userRepository.Update(userID, user)
+	if err != nil {
+		return entities.User{}, err
+	}
+
+	if emailChanged {
+		if err := service.sendVerification(user); err != nil {
+			log.Println(err)
+		}
+	}
+
+	return user, nil
 }
 
-func (service *userService) Delete(userID string) error {
+func (service *userService) Delete(actingUserID, userID string) error {
+	if err := service.authorize(actingUserID, userID); err != nil {
+		return err
+	}
+
 	return service.userRepository.Delete(userID)
 }
 
-func (service *userService) UpdatePassword(userID string, password dtos.PasswordDTO) error {
+func (service *userService) UpdatePassword(actingUserID, userID string, password dtos.PasswordDTO) error {
+	if err := service.authorize(actingUserID, userID); err != nil {
+		return err
+	}
+
 	user, err := service.userRepository.FindByID(userID)
 	if err != nil {
 		return err
 	}
 
 	if err := service.securityProvider.Verify(user.Password, password.Current); err != nil {
-		return errors.NewUnauthorizedError("a operação falhou, revise os dados e tente novamente")
+		return application.NewUnauthorizedError("a operação falhou, revise os dados e tente novamente")
 	}
 
 	hashedPassword, err := service.securityProvider.Hash(password.New)
@@ -79,3 +152,90 @@ func (service *userService) UpdatePassword(userID string, password dtos.Password
 
 	return service.userRepository.UpdatePassword(userID, string(hashedPassword))
 }
+
+func (service *userService) VerifyEmail(token string) (entities.User, error) {
+	invalidLinkError := domain.NewBadRequestError("link de verificação inválido ou expirado")
+
+	value, err := service.signer.Verify(token)
+	if err != nil {
+		return entities.User{}, invalidLinkError
+	}
+
+	// Only a value signed for verification is taken, so no other kind of
+	// signed token, such as a room grant, passes for a verification link.
+	parts := strings.SplitN(value, ":", 3)
+	if len(parts) != 3 || parts[0] != "verify" {
+		return entities.User{}, invalidLinkError
+	}
+
+	user, err := service.userRepository.FindByID(parts[1])
+	if err != nil {
+		var notFoundError *domain.ResourceNotFoundError
+		if errors.As(err, &notFoundError) {
+			return entities.User{}, invalidLinkError
+		}
+
+		return entities.User{}, err
+	}
+
+	// The link only proves ownership of the address it was sent to, so it is
+	// worthless once the account moved to another email.
+	if user.Email != parts[2] {
+		return entities.User{}, invalidLinkError
+	}
+
+	if user.IsVerified() {
+		return user, nil
+	}
+
+	return service.userRepository.MarkAsVerified(user.ID)
+}
+
+func (service *userService) ResendVerification(userID string) error {
+	user, err := service.userRepository.FindByID(userID)
+	if err != nil {
+		return err
+	}
+
+	if user.IsVerified() {
+		return domain.NewConflictError("o e-mail já foi verificado")
+	}
+
+	return service.sendVerification(user)
+}
+
+func (service *userService) sendVerification(user entities.User) error {
+	token := service.signer.Sign(verificationValue(user), time.Now().Add(service.config.VerificationTTL))
+
+	return service.mailer.Send(dtos.MailDTO{
+		To:      user.Email,
+		Subject: "Confirme seu e-mail",
+		Body:    service.buildVerificationMessage(user, token),
+	})
+}
+
+func (service *userService) buildVerificationMessage(user entities.User, token string) string {
+	link := fmt.Sprintf("%s?token=%s", service.config.VerificationURL, url.QueryEscape(token))
+
+	return fmt.Sprintf(
+		"Olá, %s!\n\nPara confirmar o seu e-mail e liberar a criação de salas, acesse o link abaixo:\n\n%s\n\nO link expira em %s.\n",
+		user.Name,
+		link,
+		service.config.VerificationTTL,
+	)
+}
+
+func (service *userService) authorize(actingUserID, userID string) error {
+	actor, err := service.userRepository.FindByID(actingUserID)
+	if err != nil {
+		return err
+	}
+
+	return service.userPolicy.CanManage(actor, userID)
+}
+
+// verificationValue ties a verification link to both the user and the address
+// it was sent to.
+func verificationValue(user entities.User) string {
+	return "verify:" + user.ID + ":" + user.Email
+}
@@ -4,19 +4,28 @@ import (
 	"encoding/json"
 	"errors"
 	"io/ioutil"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/waliqueiroz/letmeask-api/internal/application/dtos"
 	application "github.com/waliqueiroz/letmeask-api/internal/application/errors"
+	"github.com/waliqueiroz/letmeask-api/internal/application/policies"
 	"github.com/waliqueiroz/letmeask-api/internal/application/services"
 	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+	domain "github.com/waliqueiroz/letmeask-api/internal/domain/errors"
 	repositoriesMocks "github.com/waliqueiroz/letmeask-api/internal/infrastructure/database/mongodb/repositories/mocks"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/mail/memory"
+	mailMocks "github.com/waliqueiroz/letmeask-api/internal/infrastructure/mail/mocks"
 	securityMocks "github.com/waliqueiroz/letmeask-api/internal/infrastructure/security/mocks"
 )
 
 var _ = Describe("User", func() {
+	userConfig := services.UserConfig{
+		VerificationTTL: 48 * time.Hour,
+		VerificationURL: "https://letmeask.test/api/verify-email",
+	}
 
 	Describe("Executing the FindAll function", func() {
 		var result []entities.User
@@ -45,7 +54,7 @@ var _ = Describe("User", func() {
 
 				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
 
-				userService = services.NewUserService(mockUserRepository, mockSecurityProvider)
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), securityMocks.NewMockSigner(mockCtrl), memory.NewMemoryProvider(), userConfig)
 			})
 
 			It("result should be equal to expected FindAll result", func() {
@@ -66,7 +75,7 @@ var _ = Describe("User", func() {
 
 				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
 
-				userService = services.NewUserService(mockUserRepository, mockSecurityProvider)
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), securityMocks.NewMockSigner(mockCtrl), memory.NewMemoryProvider(), userConfig)
 			})
 
 			It("result should be an empty array of users", func() {
@@ -84,6 +93,7 @@ var _ = Describe("User", func() {
 		var result entities.User
 		var createError error
 		var userService services.UserService
+		var mailProvider *memory.MemoryProvider
 		var mockCtrl *gomock.Controller
 
 		JustBeforeEach(func() {
@@ -110,6 +120,7 @@ var _ = Describe("User", func() {
 
 				userWithHashedPassword := user
 				userWithHashedPassword.Password = hashedPassword
+				userWithHashedPassword.Role = entities.UserRoleUser
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
@@ -119,13 +130,24 @@ var _ = Describe("User", func() {
 				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
 				mockUserRepository.EXPECT().Create(userWithHashedPassword).Return(expectedCreateResult, nil).Times(1)
 
-				userService = services.NewUserService(mockUserRepository, mockSecurityProvider)
+				mockSigner := securityMocks.NewMockSigner(mockCtrl)
+				mockSigner.EXPECT().Sign("verify:"+expectedCreateResult.ID+":"+expectedCreateResult.Email, gomock.Any()).Return("verification-token").Times(1)
+
+				mailProvider = memory.NewMemoryProvider()
+
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), mockSigner, mailProvider, userConfig)
 			})
 
 			It("result should be equal to expected userRepository.Create result", func() {
 				Expect(result).To(Equal(expectedCreateResult))
 			})
 
+			It("should send the verification link to the user", func() {
+				Expect(mailProvider.Sent()).To(HaveLen(1))
+				Expect(mailProvider.Sent()[0].To).To(Equal(expectedCreateResult.Email))
+				Expect(mailProvider.Sent()[0].Body).To(ContainSubstring("https://letmeask.test/api/verify-email?token=verification-token"))
+			})
+
 			It("error should be nil", func() {
 				Expect(createError).Should(BeNil())
 			})
@@ -150,7 +172,7 @@ var _ = Describe("User", func() {
 
 				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
 
-				userService = services.NewUserService(mockUserRepository, mockSecurityProvider)
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), securityMocks.NewMockSigner(mockCtrl), memory.NewMemoryProvider(), userConfig)
 			})
 
 			It("result should be an empty User struct", func() {
@@ -178,6 +200,7 @@ var _ = Describe("User", func() {
 
 				userWithHashedPassword := user
 				userWithHashedPassword.Password = hashedPassword
+				userWithHashedPassword.Role = entities.UserRoleUser
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
@@ -187,7 +210,7 @@ var _ = Describe("User", func() {
 				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
 				mockUserRepository.EXPECT().Create(userWithHashedPassword).Return(entities.User{}, errors.New("an error")).Times(1)
 
-				userService = services.NewUserService(mockUserRepository, mockSecurityProvider)
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), securityMocks.NewMockSigner(mockCtrl), memory.NewMemoryProvider(), userConfig)
 			})
 
 			It("result should be an empty User struct", func() {
@@ -202,6 +225,58 @@ var _ = Describe("User", func() {
 				mockCtrl.Finish()
 			})
 		})
+
+		When("an error occurs while sending the verification link", func() {
+			var expectedCreateResult entities.User
+
+			BeforeEach(func() {
+				createUserRequestSerialized, err := ioutil.ReadFile("../../../test/resources/create_user_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				fullUserSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(createUserRequestSerialized, &user)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(fullUserSerialized, &expectedCreateResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				hashedPassword := "$2a$10$Chs8KofcRGJxJpjMl.ZS8.bJgD8iDBfyLav/oahSGVaTwBmIUUMMm"
+
+				userWithHashedPassword := user
+				userWithHashedPassword.Password = hashedPassword
+				userWithHashedPassword.Role = entities.UserRoleUser
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+				mockSecurityProvider.EXPECT().Hash(user.Password).Return(hashedPassword, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().Create(userWithHashedPassword).Return(expectedCreateResult, nil).Times(1)
+
+				mockSigner := securityMocks.NewMockSigner(mockCtrl)
+				mockSigner.EXPECT().Sign("verify:"+expectedCreateResult.ID+":"+expectedCreateResult.Email, gomock.Any()).Return("verification-token").Times(1)
+
+				mockMailer := mailMocks.NewMockMailer(mockCtrl)
+				mockMailer.EXPECT().Send(gomock.AssignableToTypeOf(dtos.MailDTO{})).Return(errors.New("an error")).Times(1)
+
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), mockSigner, mockMailer, userConfig)
+			})
+
+			It("result should still be the created user", func() {
+				Expect(result).To(Equal(expectedCreateResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(createError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
 	})
 
 	Describe("Executing the FindAll function", func() {
@@ -234,7 +309,7 @@ var _ = Describe("User", func() {
 
 				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
 
-				userService = services.NewUserService(mockUserRepository, mockSecurityProvider)
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), securityMocks.NewMockSigner(mockCtrl), memory.NewMemoryProvider(), userConfig)
 			})
 
 			It("result should be equal to expected userRepository.FindByID result", func() {
@@ -261,7 +336,7 @@ var _ = Describe("User", func() {
 
 				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
 
-				userService = services.NewUserService(mockUserRepository, mockSecurityProvider)
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), securityMocks.NewMockSigner(mockCtrl), memory.NewMemoryProvider(), userConfig)
 			})
 
 			It("result should be an empty User struct", func() {
@@ -279,6 +354,7 @@ var _ = Describe("User", func() {
 	})
 
 	Describe("Executing the Update function", func() {
+		var actingUserID string
 		var userID string
 		var userDTO dtos.UserDTO
 		var result entities.User
@@ -287,7 +363,7 @@ var _ = Describe("User", func() {
 		var mockCtrl *gomock.Controller
 
 		JustBeforeEach(func() {
-			result, updateError = userService.Update(userID, userDTO)
+			result, updateError = userService.Update(actingUserID, userID, userDTO)
 		})
 
 		When("the Update function is executed with success", func() {
@@ -306,6 +382,7 @@ var _ = Describe("User", func() {
 				err = json.Unmarshal(fullUserSerialized, &expectedUpdateResult)
 				Expect(err).NotTo(HaveOccurred())
 
+				actingUserID = "6117e377b6e7bae09f52c483"
 				userID = "6117e377b6e7bae09f52c483"
 
 				user := entities.User{
@@ -319,9 +396,10 @@ var _ = Describe("User", func() {
 				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
 
 				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(actingUserID).Return(expectedUpdateResult, nil).Times(2)
 				mockUserRepository.EXPECT().Update(userID, user).Return(expectedUpdateResult, nil).Times(1)
 
-				userService = services.NewUserService(mockUserRepository, mockSecurityProvider)
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), securityMocks.NewMockSigner(mockCtrl), memory.NewMemoryProvider(), userConfig)
 			})
 
 			It("result should be equal to expected userRepository.Update result", func() {
@@ -345,6 +423,14 @@ var _ = Describe("User", func() {
 				err = json.Unmarshal(updateUserRequestSerialized, &userDTO)
 				Expect(err).NotTo(HaveOccurred())
 
+				fullUserSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var actingUser entities.User
+				err = json.Unmarshal(fullUserSerialized, &actingUser)
+				Expect(err).NotTo(HaveOccurred())
+
+				actingUserID = "6117e377b6e7bae09f52c483"
 				userID = "6117e377b6e7bae09f52c483"
 
 				user := entities.User{
@@ -358,9 +444,10 @@ var _ = Describe("User", func() {
 				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
 
 				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(actingUserID).Return(actingUser, nil).Times(2)
 				mockUserRepository.EXPECT().Update(userID, user).Return(entities.User{}, errors.New("an error")).Times(1)
 
-				userService = services.NewUserService(mockUserRepository, mockSecurityProvider)
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), securityMocks.NewMockSigner(mockCtrl), memory.NewMemoryProvider(), userConfig)
 			})
 
 			It("result should be an empty User struct", func() {
@@ -375,30 +462,206 @@ var _ = Describe("User", func() {
 				mockCtrl.Finish()
 			})
 		})
+
+		When("an admin updates another user", func() {
+			var expectedUpdateResult entities.User
+
+			BeforeEach(func() {
+				updateUserRequestSerialized, err := ioutil.ReadFile("../../../test/resources/update_user_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				adminUserSerialized, err := ioutil.ReadFile("../../../test/resources/admin_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				fullUserSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(updateUserRequestSerialized, &userDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				var actingUser entities.User
+				err = json.Unmarshal(adminUserSerialized, &actingUser)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(fullUserSerialized, &expectedUpdateResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				actingUserID = "621f5e02e07fdbb81c8221f5"
+				userID = "6117e377b6e7bae09f52c483"
+
+				user := entities.User{
+					Name:   userDTO.Name,
+					Email:  userDTO.Email,
+					Avatar: userDTO.Avatar,
+				}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(actingUserID).Return(actingUser, nil).Times(1)
+				mockUserRepository.EXPECT().FindByID(userID).Return(expectedUpdateResult, nil).Times(1)
+				mockUserRepository.EXPECT().Update(userID, user).Return(expectedUpdateResult, nil).Times(1)
+
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), securityMocks.NewMockSigner(mockCtrl), memory.NewMemoryProvider(), userConfig)
+			})
+
+			It("result should be equal to expected userRepository.Update result", func() {
+				Expect(result).To(Equal(expectedUpdateResult))
+			})
+
+			It("error should be nil", func() {
+				Expect(updateError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("a user tries to update another user", func() {
+			BeforeEach(func() {
+				updateUserRequestSerialized, err := ioutil.ReadFile("../../../test/resources/update_user_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				fullUserSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(updateUserRequestSerialized, &userDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				var actingUser entities.User
+				err = json.Unmarshal(fullUserSerialized, &actingUser)
+				Expect(err).NotTo(HaveOccurred())
+
+				actingUserID = "6117e377b6e7bae09f52c483"
+				userID = "621f5f40e07fdbb81c8221f8"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(actingUserID).Return(actingUser, nil).Times(1)
+
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), securityMocks.NewMockSigner(mockCtrl), memory.NewMemoryProvider(), userConfig)
+			})
+
+			It("result should be an empty User struct", func() {
+				Expect(result).To(Equal(entities.User{}))
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(updateError).To(Equal(application.NewForbiddenError("você não tem permissão para alterar este usuário.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user changes the email", func() {
+			var expectedUpdateResult entities.User
+			var mailProvider *memory.MemoryProvider
+
+			BeforeEach(func() {
+				fullUserSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var currentUser entities.User
+				err = json.Unmarshal(fullUserSerialized, &currentUser)
+				Expect(err).NotTo(HaveOccurred())
+
+				verifiedAt := time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)
+				currentUser.VerifiedAt = &verifiedAt
+
+				userDTO = dtos.UserDTO{
+					Name:   currentUser.Name,
+					Email:  "novo@mail.com",
+					Avatar: currentUser.Avatar,
+				}
+
+				actingUserID = currentUser.ID
+				userID = currentUser.ID
+
+				user := entities.User{
+					Name:   userDTO.Name,
+					Email:  userDTO.Email,
+					Avatar: userDTO.Avatar,
+				}
+
+				expectedUpdateResult = currentUser
+				expectedUpdateResult.Email = userDTO.Email
+				expectedUpdateResult.VerifiedAt = nil
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(currentUser, nil).Times(2)
+				mockUserRepository.EXPECT().Update(userID, user).Return(expectedUpdateResult, nil).Times(1)
+
+				mockSigner := securityMocks.NewMockSigner(mockCtrl)
+				mockSigner.EXPECT().Sign("verify:"+userID+":novo@mail.com", gomock.Any()).Return("verification-token").Times(1)
+
+				mailProvider = memory.NewMemoryProvider()
+
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), mockSigner, mailProvider, userConfig)
+			})
+
+			It("the user should no longer be verified", func() {
+				Expect(result.IsVerified()).To(BeFalse())
+			})
+
+			It("should send the verification link to the new email", func() {
+				Expect(mailProvider.Sent()).To(HaveLen(1))
+				Expect(mailProvider.Sent()[0].To).To(Equal("novo@mail.com"))
+			})
+
+			It("error should be nil", func() {
+				Expect(updateError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
 	})
 
 	Describe("Executing the Delete function", func() {
+		var actingUserID string
 		var userID string
 		var deleteError error
 		var userService services.UserService
 		var mockCtrl *gomock.Controller
 
 		JustBeforeEach(func() {
-			deleteError = userService.Delete(userID)
+			deleteError = userService.Delete(actingUserID, userID)
 		})
 
 		When("the Delete function is executed with success", func() {
 			BeforeEach(func() {
+				fullUserSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var actingUser entities.User
+				err = json.Unmarshal(fullUserSerialized, &actingUser)
+				Expect(err).NotTo(HaveOccurred())
+
+				actingUserID = "6117e377b6e7bae09f52c483"
 				userID = "6117e377b6e7bae09f52c483"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(actingUserID).Return(actingUser, nil).Times(1)
 				mockUserRepository.EXPECT().Delete(userID).Return(nil).Times(1)
 
 				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
 
-				userService = services.NewUserService(mockUserRepository, mockSecurityProvider)
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), securityMocks.NewMockSigner(mockCtrl), memory.NewMemoryProvider(), userConfig)
 			})
 
 			It("error should be nil", func() {
@@ -408,16 +671,25 @@ var _ = Describe("User", func() {
 
 		When("an error occurs while executing the Delete function", func() {
 			BeforeEach(func() {
+				fullUserSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var actingUser entities.User
+				err = json.Unmarshal(fullUserSerialized, &actingUser)
+				Expect(err).NotTo(HaveOccurred())
+
+				actingUserID = "6117e377b6e7bae09f52c483"
 				userID = "6117e377b6e7bae09f52c483"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(actingUserID).Return(actingUser, nil).Times(1)
 				mockUserRepository.EXPECT().Delete(userID).Return(errors.New("an error")).Times(1)
 
 				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
 
-				userService = services.NewUserService(mockUserRepository, mockSecurityProvider)
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), securityMocks.NewMockSigner(mockCtrl), memory.NewMemoryProvider(), userConfig)
 			})
 
 			It("error should be the error returned by the userRepository.Delete function", func() {
@@ -428,9 +700,65 @@ var _ = Describe("User", func() {
 				mockCtrl.Finish()
 			})
 		})
+
+		When("a user tries to delete another user", func() {
+			BeforeEach(func() {
+				fullUserSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var actingUser entities.User
+				err = json.Unmarshal(fullUserSerialized, &actingUser)
+				Expect(err).NotTo(HaveOccurred())
+
+				actingUserID = "6117e377b6e7bae09f52c483"
+				userID = "621f5f40e07fdbb81c8221f8"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(actingUserID).Return(actingUser, nil).Times(1)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), securityMocks.NewMockSigner(mockCtrl), memory.NewMemoryProvider(), userConfig)
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(deleteError).To(Equal(application.NewForbiddenError("você não tem permissão para alterar este usuário.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while finding the acting user", func() {
+			BeforeEach(func() {
+				actingUserID = "6117e377b6e7bae09f52c483"
+				userID = "6117e377b6e7bae09f52c483"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(actingUserID).Return(entities.User{}, errors.New("an error")).Times(1)
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), securityMocks.NewMockSigner(mockCtrl), memory.NewMemoryProvider(), userConfig)
+			})
+
+			It("error should be the error returned by the userRepository.FindByID function", func() {
+				Expect(deleteError).To(Equal(errors.New("an error")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
 	})
 
 	Describe("Executing the UpdatePassword function", func() {
+		var actingUserID string
 		var userID string
 		var passwordDTO dtos.PasswordDTO
 		var updatePasswordError error
@@ -438,7 +766,7 @@ var _ = Describe("User", func() {
 		var mockCtrl *gomock.Controller
 
 		JustBeforeEach(func() {
-			updatePasswordError = userService.UpdatePassword(userID, passwordDTO)
+			updatePasswordError = userService.UpdatePassword(actingUserID, userID, passwordDTO)
 		})
 
 		When("the UpdatePassword function is executed with success", func() {
@@ -456,6 +784,7 @@ var _ = Describe("User", func() {
 				err = json.Unmarshal(fullUserSerialized, &expectedFindByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
+				actingUserID = "6117e377b6e7bae09f52c483"
 				userID = "6117e377b6e7bae09f52c483"
 				hashedPassword := "$2a$10$Chs8KofcRGJxJpjMl.ZS8.bJgD8iDBfyLav/oahSGVaTwBmIUUMMm"
 
@@ -466,10 +795,10 @@ var _ = Describe("User", func() {
 				mockSecurityProvider.EXPECT().Hash(passwordDTO.New).Return(hashedPassword, nil).Times(1)
 
 				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
-				mockUserRepository.EXPECT().FindByID(userID).Return(expectedFindByIDResult, nil).Times(1)
+				mockUserRepository.EXPECT().FindByID(userID).Return(expectedFindByIDResult, nil).Times(2)
 				mockUserRepository.EXPECT().UpdatePassword(userID, hashedPassword).Return(nil).Times(1)
 
-				userService = services.NewUserService(mockUserRepository, mockSecurityProvider)
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), securityMocks.NewMockSigner(mockCtrl), memory.NewMemoryProvider(), userConfig)
 			})
 
 			It("error should be nil", func() {
@@ -489,6 +818,7 @@ var _ = Describe("User", func() {
 				err = json.Unmarshal(updatePasswordRequestSerialized, &passwordDTO)
 				Expect(err).NotTo(HaveOccurred())
 
+				actingUserID = "6117e377b6e7bae09f52c483"
 				userID = "6117e377b6e7bae09f52c483"
 
 				mockCtrl = gomock.NewController(GinkgoT())
@@ -498,7 +828,7 @@ var _ = Describe("User", func() {
 				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
 				mockUserRepository.EXPECT().FindByID(userID).Return(entities.User{}, errors.New("an error")).Times(1)
 
-				userService = services.NewUserService(mockUserRepository, mockSecurityProvider)
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), securityMocks.NewMockSigner(mockCtrl), memory.NewMemoryProvider(), userConfig)
 			})
 
 			It("error should be the error returned by the userRepository.FindByID function", func() {
@@ -525,6 +855,7 @@ var _ = Describe("User", func() {
 				err = json.Unmarshal(fullUserSerialized, &expectedFindByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
+				actingUserID = "6117e377b6e7bae09f52c483"
 				userID = "6117e377b6e7bae09f52c483"
 
 				mockCtrl = gomock.NewController(GinkgoT())
@@ -533,9 +864,9 @@ var _ = Describe("User", func() {
 				mockSecurityProvider.EXPECT().Verify(expectedFindByIDResult.Password, passwordDTO.Current).Return(errors.New("an error")).Times(1)
 
 				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
-				mockUserRepository.EXPECT().FindByID(userID).Return(expectedFindByIDResult, nil).Times(1)
+				mockUserRepository.EXPECT().FindByID(userID).Return(expectedFindByIDResult, nil).Times(2)
 
-				userService = services.NewUserService(mockUserRepository, mockSecurityProvider)
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), securityMocks.NewMockSigner(mockCtrl), memory.NewMemoryProvider(), userConfig)
 			})
 
 			It("error should be an unauthorized error", func() {
@@ -562,6 +893,7 @@ var _ = Describe("User", func() {
 				err = json.Unmarshal(fullUserSerialized, &expectedFindByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
+				actingUserID = "6117e377b6e7bae09f52c483"
 				userID = "6117e377b6e7bae09f52c483"
 
 				mockCtrl = gomock.NewController(GinkgoT())
@@ -571,9 +903,9 @@ var _ = Describe("User", func() {
 				mockSecurityProvider.EXPECT().Hash(passwordDTO.New).Return("", errors.New("an error")).Times(1)
 
 				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
-				mockUserRepository.EXPECT().FindByID(userID).Return(expectedFindByIDResult, nil).Times(1)
+				mockUserRepository.EXPECT().FindByID(userID).Return(expectedFindByIDResult, nil).Times(2)
 
-				userService = services.NewUserService(mockUserRepository, mockSecurityProvider)
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), securityMocks.NewMockSigner(mockCtrl), memory.NewMemoryProvider(), userConfig)
 			})
 
 			It("error should be the error returned by the securityProvider.Hash function", func() {
@@ -600,6 +932,7 @@ var _ = Describe("User", func() {
 				err = json.Unmarshal(fullUserSerialized, &expectedFindByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
+				actingUserID = "6117e377b6e7bae09f52c483"
 				userID = "6117e377b6e7bae09f52c483"
 				hashedPassword := "$2a$10$Chs8KofcRGJxJpjMl.ZS8.bJgD8iDBfyLav/oahSGVaTwBmIUUMMm"
 
@@ -610,10 +943,10 @@ var _ = Describe("User", func() {
 				mockSecurityProvider.EXPECT().Hash(passwordDTO.New).Return(hashedPassword, nil).Times(1)
 
 				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
-				mockUserRepository.EXPECT().FindByID(userID).Return(expectedFindByIDResult, nil).Times(1)
+				mockUserRepository.EXPECT().FindByID(userID).Return(expectedFindByIDResult, nil).Times(2)
 				mockUserRepository.EXPECT().UpdatePassword(userID, hashedPassword).Return(errors.New("an error")).Times(1)
 
-				userService = services.NewUserService(mockUserRepository, mockSecurityProvider)
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), securityMocks.NewMockSigner(mockCtrl), memory.NewMemoryProvider(), userConfig)
 			})
 
 			It("error should be the error returned by the userRepository.UpdatePassword function", func() {
@@ -624,6 +957,294 @@ var _ = Describe("User", func() {
 				mockCtrl.Finish()
 			})
 		})
+
+		When("a user tries to update the password of another user", func() {
+			BeforeEach(func() {
+				updatePasswordRequestSerialized, err := ioutil.ReadFile("../../../test/resources/update_password_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(updatePasswordRequestSerialized, &passwordDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				fullUserSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var actingUser entities.User
+				err = json.Unmarshal(fullUserSerialized, &actingUser)
+				Expect(err).NotTo(HaveOccurred())
+
+				actingUserID = "6117e377b6e7bae09f52c483"
+				userID = "621f5f40e07fdbb81c8221f8"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(actingUserID).Return(actingUser, nil).Times(1)
+
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), securityMocks.NewMockSigner(mockCtrl), memory.NewMemoryProvider(), userConfig)
+			})
+
+			It("error should be a forbidden error", func() {
+				Expect(updatePasswordError).To(Equal(application.NewForbiddenError("você não tem permissão para alterar este usuário.")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the VerifyEmail function", func() {
+		var token string
+		var result entities.User
+		var verifyEmailError error
+		var userService services.UserService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			result, verifyEmailError = userService.VerifyEmail(token)
+		})
+
+		When("the email is verified with success", func() {
+			var expectedVerifiedUser entities.User
+
+			BeforeEach(func() {
+				token = "verification-token"
+
+				var user entities.User
+				userSerialized, err := ioutil.ReadFile("../../../test/resources/full_user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(userSerialized, &user)
+				Expect(err).NotTo(HaveOccurred())
+
+				verifiedAt := time.Now()
+				expectedVerifiedUser = user
+				expectedVerifiedUser.VerifiedAt = &verifiedAt
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockSigner := securityMocks.NewMockSigner(mockCtrl)
+				mockSigner.EXPECT().Verify(token).Return("verify:"+user.ID+":"+user.Email, nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(user.ID).Return(user, nil).Times(1)
+				mockUserRepository.EXPECT().MarkAsVerified(user.ID).Return(expectedVerifiedUser, nil).Times(1)
+
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), mockSigner, memory.NewMemoryProvider(), userConfig)
+			})
+
+			It("result should be equal to expected userRepository.MarkAsVerified result", func() {
+				Expect(result).To(Equal(expectedVerifiedUser))
+			})
+
+			It("error should be nil", func() {
+				Expect(verifyEmailError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the link signature is not valid or expired", func() {
+			BeforeEach(func() {
+				token = "tampered-token"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockSigner := securityMocks.NewMockSigner(mockCtrl)
+				mockSigner.EXPECT().Verify(token).Return("", errors.New("invalid signature")).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), mockSigner, memory.NewMemoryProvider(), userConfig)
+			})
+
+			It("result should be an empty User struct", func() {
+				Expect(result).To(Equal(entities.User{}))
+			})
+
+			It("error should be a bad request error", func() {
+				Expect(verifyEmailError).To(Equal(domain.NewBadRequestError("link de verificação inválido ou expirado")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the token was signed for another purpose", func() {
+			BeforeEach(func() {
+				token = "grant"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockSigner := securityMocks.NewMockSigner(mockCtrl)
+				mockSigner.EXPECT().Verify(token).Return("room:621f5ec1e07fdbb81c8221f7:6117e377b6e7bae09f52c483", nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), mockSigner, memory.NewMemoryProvider(), userConfig)
+			})
+
+			It("result should be an empty User struct", func() {
+				Expect(result).To(Equal(entities.User{}))
+			})
+
+			It("error should be a bad request error", func() {
+				Expect(verifyEmailError).To(Equal(domain.NewBadRequestError("link de verificação inválido ou expirado")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user changed the email after the link was sent", func() {
+			BeforeEach(func() {
+				token = "verification-token"
+
+				user := entities.User{ID: "6117e377b6e7bae09f52c483", Email: "novo@mail.com"}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockSigner := securityMocks.NewMockSigner(mockCtrl)
+				mockSigner.EXPECT().Verify(token).Return("verify:"+user.ID+":antigo@mail.com", nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(user.ID).Return(user, nil).Times(1)
+
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), mockSigner, memory.NewMemoryProvider(), userConfig)
+			})
+
+			It("result should be an empty User struct", func() {
+				Expect(result).To(Equal(entities.User{}))
+			})
+
+			It("error should be a bad request error", func() {
+				Expect(verifyEmailError).To(Equal(domain.NewBadRequestError("link de verificação inválido ou expirado")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user no longer exists", func() {
+			BeforeEach(func() {
+				token = "verification-token"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockSigner := securityMocks.NewMockSigner(mockCtrl)
+				mockSigner.EXPECT().Verify(token).Return("verify:6117e377b6e7bae09f52c483:teste1@mail.com", nil).Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID("6117e377b6e7bae09f52c483").Return(entities.User{}, domain.NewResourceNotFoundError("usuário não encontrado")).Times(1)
+
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), mockSigner, memory.NewMemoryProvider(), userConfig)
+			})
+
+			It("error should be a bad request error", func() {
+				Expect(verifyEmailError).To(Equal(domain.NewBadRequestError("link de verificação inválido ou expirado")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Executing the ResendVerification function", func() {
+		var userID string
+		var resendError error
+		var mailProvider *memory.MemoryProvider
+		var userService services.UserService
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			resendError = userService.ResendVerification(userID)
+		})
+
+		When("the verification link is sent again", func() {
+			BeforeEach(func() {
+				user := entities.User{ID: "6117e377b6e7bae09f52c483", Name: "Teste 1", Email: "teste1@mail.com"}
+				userID = user.ID
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockSigner := securityMocks.NewMockSigner(mockCtrl)
+				mockSigner.EXPECT().Sign("verify:"+user.ID+":"+user.Email, gomock.Any()).Return("verification-token").Times(1)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(user, nil).Times(1)
+
+				mailProvider = memory.NewMemoryProvider()
+
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), mockSigner, mailProvider, userConfig)
+			})
+
+			It("should send the verification link to the user", func() {
+				Expect(mailProvider.Sent()).To(HaveLen(1))
+				Expect(mailProvider.Sent()[0].To).To(Equal("teste1@mail.com"))
+			})
+
+			It("error should be nil", func() {
+				Expect(resendError).Should(BeNil())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the email is already verified", func() {
+			BeforeEach(func() {
+				verifiedAt := time.Now()
+				user := entities.User{ID: "6117e377b6e7bae09f52c483", Email: "teste1@mail.com", VerifiedAt: &verifiedAt}
+				userID = user.ID
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockSecurityProvider := securityMocks.NewMockSecurityProvider(mockCtrl)
+
+				mockSigner := securityMocks.NewMockSigner(mockCtrl)
+
+				mockUserRepository := repositoriesMocks.NewMockUserRepository(mockCtrl)
+				mockUserRepository.EXPECT().FindByID(userID).Return(user, nil).Times(1)
+
+				mailProvider = memory.NewMemoryProvider()
+
+				userService = services.NewUserService(mockUserRepository, mockSecurityProvider, policies.NewUserPolicy(), mockSigner, mailProvider, userConfig)
+			})
+
+			It("should not send any mail", func() {
+				Expect(mailProvider.Sent()).To(BeEmpty())
+			})
+
+			It("error should be a conflict error", func() {
+				Expect(resendError).To(Equal(domain.NewConflictError("o e-mail já foi verificado")))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
 	})
 
 })
@@ -0,0 +1,16 @@
+package entities
+
+import "time"
+
+const (
+	RoomRoleOwner     = "owner"
+	RoomRoleCoHost    = "co_host"
+	RoomRoleModerator = "moderator"
+	RoomRoleAttendee  = "attendee"
+)
+
+type Member struct {
+	User    Author    `json:"user"`
+	Role    string    `json:"role"`
+	AddedAt time.Time `json:"added_at"`
+}
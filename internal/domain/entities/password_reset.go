@@ -0,0 +1,16 @@
+package entities
+
+import "time"
+
+type PasswordReset struct {
+	ID        string     `json:"id"`
+	UserID    string     `json:"user_id"`
+	TokenHash string     `json:"-"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+func (reset *PasswordReset) IsValid() bool {
+	return reset.UsedAt == nil && time.Now().Before(reset.ExpiresAt)
+}
@@ -0,0 +1,74 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/letmeask-api/internal/domain/errors"
+)
+
+const (
+	PollStatusDraft  = "draft"
+	PollStatusOpen   = "open"
+	PollStatusClosed = "closed"
+)
+
+type Poll struct {
+	ID             string       `json:"id"`
+	Question       string       `json:"question"`
+	Options        []PollOption `json:"options"`
+	MultipleChoice bool         `json:"multiple_choice"`
+	Status         string       `json:"status"`
+	Votes          []PollVote   `json:"-"`
+	TotalVotes     int          `json:"total_votes"`
+	CreatedAt      time.Time    `json:"created_at"`
+	ClosedAt       *time.Time   `json:"closed_at,omitempty"`
+}
+
+type PollOption struct {
+	ID        string `json:"id"`
+	Text      string `json:"text"`
+	VoteCount int    `json:"vote_count"`
+}
+
+type PollVote struct {
+	UserID    string
+	OptionIDs []string
+	CreatedAt time.Time
+}
+
+func (poll *Poll) FindOption(optionID string) (PollOption, error) {
+	for _, option := range poll.Options {
+		if option.ID == optionID {
+			return option, nil
+		}
+	}
+
+	return PollOption{}, errors.NewResourceNotFoundError("opção não encontrada.")
+}
+
+func (poll *Poll) HasVoted(userID string) bool {
+	for _, vote := range poll.Votes {
+		if vote.UserID == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Tally counts the votes of each option. The total is the number of people
+// who voted, which on multiple choice polls is less than the sum of the options.
+func (poll *Poll) Tally() {
+	counts := map[string]int{}
+	for _, vote := range poll.Votes {
+		for _, optionID := range vote.OptionIDs {
+			counts[optionID]++
+		}
+	}
+
+	for index := range poll.Options {
+		poll.Options[index].VoteCount = counts[poll.Options[index].ID]
+	}
+
+	poll.TotalVotes = len(poll.Votes)
+}
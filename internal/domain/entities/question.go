@@ -1,32 +1,107 @@
 package entities
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/waliqueiroz/letmeask-api/internal/domain/errors"
+)
+
+const (
+	QuestionStatusPending  = "pending"
+	QuestionStatusApproved = "approved"
+	QuestionStatusRejected = "rejected"
+)
+
+const (
+	QuestionWithdrawn = "withdrawn"
+	QuestionRemoved   = "removed"
+)
 
 type Question struct {
-	ID            string    `json:"id"`
-	Content       string    `json:"content" validate:"required"`
-	IsHighlighted bool      `json:"is_highlighted"`
-	IsAnswered    bool      `json:"is_answered"`
-	Author        Author    `json:"author" validate:"dive"`
-	Likes         []Like    `json:"likes,omitempty"`
-	CreatedAt     time.Time `json:"created_at"`
+	ID            string             `json:"id"`
+	Content       string             `json:"content" validate:"required"`
+	IsHighlighted bool               `json:"is_highlighted"`
+	IsAnswered    bool               `json:"is_answered"`
+	IsAnonymous   bool               `json:"is_anonymous"`
+	Status        string             `json:"status,omitempty"`
+	Author        Author             `json:"author" validate:"dive"`
+	Likes         []Like             `json:"likes,omitempty"`
+	Replies       []Reply            `json:"replies,omitempty"`
+	AnswerID      string             `json:"answer_id,omitempty"`
+	Revisions     []QuestionRevision `json:"-"`
+	CreatedAt     time.Time          `json:"created_at"`
+	EditedAt      *time.Time         `json:"edited_at,omitempty"`
+	Deletion      *QuestionDeletion  `json:"deletion,omitempty"`
 }
 
-func (question *Question) AddLike(newLike Like) {
+// QuestionRevision is a past content of an edited question, along with when
+// it was written.
+type QuestionRevision struct {
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (question *Question) FindLike(likeID string) (Like, error) {
 	for _, like := range question.Likes {
-		if like.Author.ID == newLike.Author.ID {
-			return
+		if like.ID == likeID {
+			return like, nil
 		}
 	}
 
-	question.Likes = append(question.Likes, newLike)
+	return Like{}, errors.NewResourceNotFoundError("curtida não encontrada.")
 }
 
-func (question *Question) RemoveLike(likeID string) {
-	for key, like := range question.Likes {
-		if like.ID == likeID {
-			question.Likes = append(question.Likes[:key], question.Likes[key+1:]...)
-			break
+func (question *Question) FindReply(replyID string) (Reply, error) {
+	for _, reply := range question.Replies {
+		if reply.ID == replyID {
+			return reply, nil
 		}
 	}
+
+	return Reply{}, errors.NewResourceNotFoundError("resposta não encontrada.")
+}
+
+// QuestionDeletion is the tombstone left by a deleted question. The reason
+// tells whether the author withdrew the question or the room staff removed it.
+type QuestionDeletion struct {
+	Reason    string    `json:"reason"`
+	DeletedBy string    `json:"deleted_by"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+func (question *Question) IsDeleted() bool {
+	return question.Deletion != nil
+}
+
+// IsPublished tells whether the audience can see the question. Questions
+// asked before moderation existed have no status and are published.
+func (question *Question) IsPublished() bool {
+	return question.Status == "" || question.Status == QuestionStatusApproved
+}
+
+// Anonymized hides the author of an anonymous question. The real author is
+// only kept for those who moderate the room.
+func (question *Question) Anonymized() Question {
+	anonymized := *question
+	if anonymized.IsAnonymous {
+		anonymized.Author = Author{}
+	}
+
+	return anonymized
+}
+
+func (question Question) MarshalJSON() ([]byte, error) {
+	type Alias Question
+
+	if question.IsAnonymous && question.Author == (Author{}) {
+		return json.Marshal(struct {
+			Alias
+			Author *Author `json:"author,omitempty"`
+		}{
+			Alias: Alias(question),
+		})
+	}
+
+	return json.Marshal(Alias(question))
 }
@@ -0,0 +1,12 @@
+package entities
+
+import "time"
+
+type Reply struct {
+	ID         string     `json:"id"`
+	Content    string     `json:"content"`
+	Author     Author     `json:"author"`
+	IsOfficial bool       `json:"is_official"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
@@ -1,76 +1,247 @@
 package entities
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/waliqueiroz/letmeask-api/internal/domain/errors"
 )
 
 type Room struct {
-	ID        string     `json:"id"`
-	Title     string     `json:"title" validate:"required"`
-	Questions []Question `json:"questions,omitempty"`
-	Author    Author     `json:"author" validate:"required"`
-	EndedAt   *time.Time `json:"ended_at,omitempty"`
-	CreatedAt time.Time  `json:"created_at"`
-	UpdatedAt time.Time  `json:"updated_at"`
-}
-
-func (room *Room) AddQuestion(question Question) {
-	question.CreatedAt = time.Now()
-	room.Questions = append(room.Questions, question)
-}
-
-func (room *Room) LikeQuestion(questionID string, like Like) error {
-	like.CreatedAt = time.Now()
-	for key, question := range room.Questions {
-		if question.ID == questionID {
-			room.Questions[key].AddLike(like)
-			return nil
+	ID               string       `json:"id"`
+	Code             string       `json:"code,omitempty"`
+	Title            string       `json:"title" validate:"required"`
+	Questions        []Question   `json:"questions,omitempty"`
+	QuestionCount    int          `json:"question_count,omitempty"`
+	Author           Author       `json:"author" validate:"required"`
+	Members          []Member     `json:"members,omitempty"`
+	Polls            []Poll       `json:"polls,omitempty"`
+	Settings         RoomSettings `json:"settings"`
+	ScheduledStartAt *time.Time   `json:"scheduled_start_at,omitempty"`
+	ScheduledEndAt   *time.Time   `json:"scheduled_end_at,omitempty"`
+	EndedAt          *time.Time   `json:"ended_at,omitempty"`
+	Version          int64        `json:"version"`
+	CreatedAt        time.Time    `json:"created_at"`
+	UpdatedAt        time.Time    `json:"updated_at"`
+}
+
+// FindQuestion only finds the questions that were not deleted.
+func (room *Room) FindQuestion(questionID string) (Question, error) {
+	for _, question := range room.Questions {
+		if question.ID == questionID && !question.IsDeleted() {
+			return question, nil
+		}
+	}
+
+	return Question{}, errors.NewResourceNotFoundError("pergunta não encontrada.")
+}
+
+func (room *Room) FindPoll(pollID string) (Poll, error) {
+	for _, poll := range room.Polls {
+		if poll.ID == pollID {
+			return poll, nil
+		}
+	}
+
+	return Poll{}, errors.NewResourceNotFoundError("enquete não encontrada.")
+}
+
+// RoleOf tells which role a user holds in the room. The author is always the
+// owner, even in rooms created before members existed, and anyone else who
+// is not listed as a member is an attendee.
+func (room *Room) RoleOf(userID string) string {
+	if userID == room.Author.ID {
+		return RoomRoleOwner
+	}
+
+	for _, member := range room.Members {
+		if member.User.ID == userID {
+			return member.Role
+		}
+	}
+
+	return RoomRoleAttendee
+}
+
+// IsMember tells whether the user was added to the room, the owner included.
+func (room *Room) IsMember(userID string) bool {
+	if userID == room.Author.ID {
+		return true
+	}
+
+	_, err := room.FindMember(userID)
+
+	return err == nil
+}
+
+func (room *Room) FindMember(userID string) (Member, error) {
+	for _, member := range room.Members {
+		if member.User.ID == userID {
+			return member, nil
+		}
+	}
+
+	return Member{}, errors.NewResourceNotFoundError("membro não encontrado.")
+}
+
+// HasStarted tells whether the scheduled start of the room, if any, has come.
+func (room *Room) HasStarted(now time.Time) bool {
+	return room.ScheduledStartAt == nil || !now.Before(*room.ScheduledStartAt)
+}
+
+// IsEnded also counts a room whose scheduled end has passed, even before the
+// scheduler gets to set its EndedAt.
+func (room *Room) IsEnded(now time.Time) bool {
+	if room.EndedAt != nil {
+		return true
+	}
+
+	return room.ScheduledEndAt != nil && !now.Before(*room.ScheduledEndAt)
+}
+
+// EndedWithin tells whether the room ended less than the given period ago.
+func (room *Room) EndedWithin(period time.Duration, now time.Time) bool {
+	settled := room.Settled(now)
+
+	return settled.EndedAt != nil && now.Before(settled.EndedAt.Add(period))
+}
+
+// EnsureNotEnded is the rule that makes an ended room read-only: nothing in
+// it may change until it is reopened.
+func (room *Room) EnsureNotEnded(now time.Time) error {
+	if room.IsEnded(now) {
+		return errors.NewRoomEndedError("a sala já foi encerrada.")
+	}
+
+	return nil
+}
+
+// AcceptsQuestions only lets questions in between the opening and the
+// closing of the room.
+func (room *Room) AcceptsQuestions(now time.Time) error {
+	if !room.HasStarted(now) {
+		return errors.NewConflictError("a sala ainda não foi aberta.")
+	}
+
+	return room.EnsureNotEnded(now)
+}
+
+// CheckQuestionLimits enforces the limits the room sets on how many questions
+// each user may ask and how often. Deleted questions still count towards the
+// total, otherwise withdrawing them would be a way around it. Only waiting
+// between questions is a matter of time; the caps are conflicts, since there
+// is no telling when, if ever, asking again would work.
+func (room *Room) CheckQuestionLimits(userID string, now time.Time) error {
+	settings := room.Settings
+
+	var asked, open int
+	var lastAskedAt time.Time
+
+	for _, question := range room.Questions {
+		if question.Author.ID != userID {
+			continue
+		}
+
+		asked++
+
+		if question.CreatedAt.After(lastAskedAt) {
+			lastAskedAt = question.CreatedAt
+		}
+
+		if !question.IsDeleted() && !question.IsAnswered && question.Status != QuestionStatusRejected {
+			open++
 		}
 	}
 
-	return errors.NewResourceNotFoundError("pergunta não encontrada.")
+	if settings.MaxQuestionsPerUser > 0 && asked >= settings.MaxQuestionsPerUser {
+		return errors.NewConflictError(fmt.Sprintf("você atingiu o limite de %d perguntas por pessoa nesta sala.", settings.MaxQuestionsPerUser))
+	}
+
+	if settings.MinSecondsBetweenQuestions > 0 && asked > 0 {
+		nextAllowedAt := lastAskedAt.Add(time.Duration(settings.MinSecondsBetweenQuestions) * time.Second)
+		if now.Before(nextAllowedAt) {
+			return errors.NewRateLimitError(nextAllowedAt.Sub(now), "aguarde um pouco antes de fazer outra pergunta.")
+		}
+	}
+
+	if settings.MaxOpenQuestionsPerUser > 0 && open >= settings.MaxOpenQuestionsPerUser {
+		return errors.NewConflictError("espere que suas perguntas sejam respondidas antes de fazer outras.")
+	}
+
+	return nil
 }
 
-func (room *Room) DeslikeQuestion(questionID string, likeID string) error {
-	for key, question := range room.Questions {
-		if question.ID == questionID {
-			room.Questions[key].RemoveLike(likeID)
-			return nil
+// Settled reports a room whose scheduled end has passed as ended at that
+// time, so reads agree with what the scheduler is about to store.
+func (room *Room) Settled(now time.Time) Room {
+	settled := *room
+
+	if settled.EndedAt == nil && settled.IsEnded(now) {
+		settled.EndedAt = settled.ScheduledEndAt
+	}
+
+	return settled
+}
+
+// Anonymized hides the authors of every anonymous question in the room.
+func (room *Room) Anonymized() Room {
+	anonymized := *room
+
+	if room.Questions != nil {
+		anonymized.Questions = make([]Question, len(room.Questions))
+		for index, question := range room.Questions {
+			anonymized.Questions[index] = question.Anonymized()
 		}
 	}
 
-	return errors.NewResourceNotFoundError("pergunta não encontrada.")
+	return anonymized
 }
 
-func (room *Room) MarkQuestionAsAnswered(questionID string) error {
-	for key, question := range room.Questions {
-		if question.ID == questionID {
-			room.Questions[key].IsAnswered = true
-			return nil
+// Visible leaves out the deleted questions, which are only kept for auditing.
+func (room *Room) Visible() Room {
+	visible := *room
+
+	if room.Questions != nil {
+		visible.Questions = []Question{}
+		for _, question := range room.Questions {
+			if !question.IsDeleted() {
+				visible.Questions = append(visible.Questions, question)
+			}
 		}
 	}
 
-	return errors.NewResourceNotFoundError("pergunta não encontrada.")
+	return visible
 }
 
-func (room *Room) UpdateQuestionHighlight(questionID string, highligh bool) error {
-	for key, question := range room.Questions {
-		if question.ID == questionID {
-			room.Questions[key].IsHighlighted = highligh
-			return nil
+// Published leaves out the questions still waiting for moderation and the
+// rejected ones.
+func (room *Room) Published() Room {
+	published := *room
+
+	if room.Questions != nil {
+		published.Questions = []Question{}
+		for _, question := range room.Questions {
+			if question.IsPublished() {
+				published.Questions = append(published.Questions, question)
+			}
 		}
 	}
 
-	return errors.NewResourceNotFoundError("pergunta não encontrada.")
+	return published
 }
 
-func (room *Room) DeleteQuestion(questionID string) {
-	for key, question := range room.Questions {
-		if question.ID == questionID {
-			room.Questions = append(room.Questions[:key], room.Questions[key+1:]...)
-			break
+// Launched leaves out the polls that were not opened yet.
+func (room *Room) Launched() Room {
+	launched := *room
+
+	if room.Polls != nil {
+		launched.Polls = []Poll{}
+		for _, poll := range room.Polls {
+			if poll.Status != PollStatusDraft {
+				launched.Polls = append(launched.Polls, poll)
+			}
 		}
 	}
+
+	return launched
 }
@@ -0,0 +1,66 @@
+package entities
+
+import "strings"
+
+const (
+	RoomVisibilityPublic     = "public"
+	RoomVisibilityPasscode   = "passcode"
+	RoomVisibilityInviteOnly = "invite_only"
+)
+
+// RoomSettings holds how the room works. The question limits apply to each
+// user, and zero means no limit.
+type RoomSettings struct {
+	AllowAnonymousQuestions    bool     `json:"allow_anonymous_questions"`
+	RequireApproval            bool     `json:"require_approval"`
+	Visibility                 string   `json:"visibility,omitempty"`
+	Passcode                   string   `json:"-"`
+	InvitedUserIDs             []string `json:"invited_user_ids,omitempty"`
+	InvitedDomains             []string `json:"invited_domains,omitempty"`
+	MaxQuestionsPerUser        int      `json:"max_questions_per_user,omitempty"`
+	MinSecondsBetweenQuestions int      `json:"min_seconds_between_questions,omitempty"`
+	MaxOpenQuestionsPerUser    int      `json:"max_open_questions_per_user,omitempty"`
+}
+
+// IsPublic also holds for the rooms created before visibility existed.
+func (settings RoomSettings) IsPublic() bool {
+	return settings.Visibility == "" || settings.Visibility == RoomVisibilityPublic
+}
+
+// Invites tells whether the user is on the invite list, either by ID or by
+// the domain of the email. The domain only counts once the email is verified,
+// since anyone can sign up with an address they do not own.
+func (settings RoomSettings) Invites(user User) bool {
+	for _, userID := range settings.InvitedUserIDs {
+		if userID == user.ID {
+			return true
+		}
+	}
+
+	if !user.IsVerified() {
+		return false
+	}
+
+	at := strings.LastIndex(user.Email, "@")
+	if at < 0 {
+		return false
+	}
+
+	domain := strings.ToLower(user.Email[at+1:])
+	for _, invited := range settings.InvitedDomains {
+		if invited == domain {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WithoutInvites leaves out who was invited, which is only for those who
+// manage the room to see.
+func (settings RoomSettings) WithoutInvites() RoomSettings {
+	settings.InvitedUserIDs = nil
+	settings.InvitedDomains = nil
+
+	return settings
+}
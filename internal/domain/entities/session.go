@@ -0,0 +1,19 @@
+package entities
+
+import "time"
+
+type Session struct {
+	ID              string     `json:"id"`
+	UserID          string     `json:"user_id"`
+	FamilyID        string     `json:"family_id"`
+	TokenHash       string     `json:"-"`
+	TokenGeneration int        `json:"-"`
+	ExpiresAt       time.Time  `json:"expires_at"`
+	RotatedAt       *time.Time `json:"rotated_at,omitempty"`
+	RevokedAt       *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+func (session *Session) IsActive() bool {
+	return session.RotatedAt == nil && session.RevokedAt == nil && time.Now().Before(session.ExpiresAt)
+}
@@ -5,14 +5,45 @@ import (
 	"time"
 )
 
+const (
+	UserRoleUser  = "user"
+	UserRoleAdmin = "admin"
+)
+
 type User struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name" validate:"required"`
-	Avatar    string    `json:"avatar" validate:"required"`
-	Email     string    `json:"email" validate:"required,email"`
-	Password  string    `json:"password" validate:"required"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID              string     `json:"id"`
+	Name            string     `json:"name" validate:"required"`
+	Avatar          string     `json:"avatar" validate:"required"`
+	Email           string     `json:"email" validate:"required,email"`
+	Password        string     `json:"password" validate:"required"`
+	Role            string     `json:"role"`
+	TokenGeneration int        `json:"-"`
+	FailedLogins    int        `json:"-"`
+	LockedUntil     *time.Time `json:"-"`
+	VerifiedAt      *time.Time `json:"verified_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+func (u User) IsAdmin() bool {
+	return u.Role == UserRoleAdmin
+}
+
+func (u User) IsVerified() bool {
+	return u.VerifiedAt != nil
+}
+
+// IsLocked tells whether logging in is blocked after too many failed attempts.
+func (u User) IsLocked(now time.Time) bool {
+	return u.LockedUntil != nil && now.Before(*u.LockedUntil)
+}
+
+func (u User) ToAuthor() Author {
+	return Author{
+		ID:     u.ID,
+		Name:   u.Name,
+		Avatar: u.Avatar,
+	}
 }
 
 func (u User) MarshalJSON() ([]byte, error) {
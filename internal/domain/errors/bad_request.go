@@ -0,0 +1,32 @@
+package errors
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type BadRequestError struct {
+	Message string
+}
+
+func NewBadRequestError(message ...string) *BadRequestError {
+	defaultMessage := "Bad Request"
+
+	err := &BadRequestError{
+		Message: defaultMessage,
+	}
+
+	if len(message) > 0 {
+		err.Message = fmt.Sprintf("%s: %s", defaultMessage, message[0])
+	}
+
+	return err
+}
+
+func (err *BadRequestError) Error() string {
+	return err.Message
+}
+
+func (*BadRequestError) Code() int {
+	return http.StatusBadRequest
+}
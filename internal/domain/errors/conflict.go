@@ -0,0 +1,32 @@
+package errors
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type ConflictError struct {
+	Message string
+}
+
+func NewConflictError(message ...string) *ConflictError {
+	defaultMessage := "Conflict"
+
+	err := &ConflictError{
+		Message: defaultMessage,
+	}
+
+	if len(message) > 0 {
+		err.Message = fmt.Sprintf("%s: %s", defaultMessage, message[0])
+	}
+
+	return err
+}
+
+func (err *ConflictError) Error() string {
+	return err.Message
+}
+
+func (*ConflictError) Code() int {
+	return http.StatusConflict
+}
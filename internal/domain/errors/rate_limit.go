@@ -0,0 +1,38 @@
+package errors
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// RateLimitError is returned when someone does something too often.
+// RetryAfter tells how long to wait before trying again, and is zero when
+// waiting alone will not help.
+type RateLimitError struct {
+	Message    string
+	RetryAfter time.Duration
+}
+
+func NewRateLimitError(retryAfter time.Duration, message ...string) *RateLimitError {
+	defaultMessage := "Too Many Requests"
+
+	err := &RateLimitError{
+		Message:    defaultMessage,
+		RetryAfter: retryAfter,
+	}
+
+	if len(message) > 0 {
+		err.Message = fmt.Sprintf("%s: %s", defaultMessage, message[0])
+	}
+
+	return err
+}
+
+func (err *RateLimitError) Error() string {
+	return err.Message
+}
+
+func (*RateLimitError) Code() int {
+	return http.StatusTooManyRequests
+}
@@ -0,0 +1,34 @@
+package errors
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// RoomEndedError is returned when something tries to change a room that is
+// already over, which stays readable but no longer takes part in anything.
+type RoomEndedError struct {
+	Message string
+}
+
+func NewRoomEndedError(message ...string) *RoomEndedError {
+	defaultMessage := "Gone"
+
+	err := &RoomEndedError{
+		Message: defaultMessage,
+	}
+
+	if len(message) > 0 {
+		err.Message = fmt.Sprintf("%s: %s", defaultMessage, message[0])
+	}
+
+	return err
+}
+
+func (err *RoomEndedError) Error() string {
+	return err.Message
+}
+
+func (*RoomEndedError) Code() int {
+	return http.StatusGone
+}
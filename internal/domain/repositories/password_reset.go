@@ -0,0 +1,9 @@
+package repositories
+
+import "github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+
+type PasswordResetRepository interface {
+	Create(reset entities.PasswordReset) (entities.PasswordReset, error)
+	FindByTokenHash(tokenHash string) (entities.PasswordReset, error)
+	MarkAsUsed(resetID string) error
+}
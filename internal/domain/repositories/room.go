@@ -1,9 +1,78 @@
 package repositories
 
-import "github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+import (
+	"time"
+
+	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+)
+
+const (
+	RoomStatusOpen  = "open"
+	RoomStatusEnded = "ended"
+)
+
+const (
+	QuestionOrderNewest           = "newest"
+	QuestionOrderOldest           = "oldest"
+	QuestionOrderMostLiked        = "most_liked"
+	QuestionOrderHighlightedFirst = "highlighted_first"
+	QuestionOrderUnansweredFirst  = "unanswered_first"
+)
+
+// RoomFilter leaves out the private rooms ViewerID does not belong to.
+type RoomFilter struct {
+	ViewerID    string
+	AuthorID    string
+	Status      string
+	Search      string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	SortBy      string
+	Descending  bool
+	Cursor      string
+	Limit       int
+}
+
+type RoomPage struct {
+	Rooms      []entities.Room
+	NextCursor string
+}
+
+type QuestionFilter struct {
+	Order         string
+	Status        string
+	Deleted       bool
+	IsAnswered    *bool
+	IsHighlighted *bool
+	Cursor        string
+	Limit         int
+}
+
+type QuestionPage struct {
+	Questions  []entities.Question
+	NextCursor string
+}
 
 type RoomRepository interface {
 	Create(room entities.Room) (entities.Room, error)
+	FindAll(filter RoomFilter) (RoomPage, error)
 	FindByID(roomID string) (entities.Room, error)
+	FindByCode(code string) (entities.Room, error)
+	FindDueRooms(now time.Time) ([]entities.Room, error)
+	FindSummaryByID(roomID string) (entities.Room, error)
+	FindQuestions(roomID string, filter QuestionFilter) (QuestionPage, error)
 	Update(roomID string, room entities.Room) (entities.Room, error)
+	PushQuestion(roomID string, question entities.Question, limited bool) (entities.Question, error)
+	AddLike(roomID string, questionID string, like entities.Like) (entities.Room, error)
+	RemoveLike(roomID string, questionID string, likeID string) (entities.Room, error)
+	SetQuestionHighlight(roomID string, questionID string, isHighlighted bool) (entities.Room, error)
+	MarkQuestionAsDeleted(roomID string, questionID string, deletion entities.QuestionDeletion) (entities.Room, error)
+	MarkQuestionAsAnswered(roomID string, questionID string, answerID string) (entities.Room, error)
+	SetQuestionStatus(roomID string, questionID string, status string) (entities.Room, error)
+	AddReply(roomID string, questionID string, reply entities.Reply) (entities.Room, error)
+	UpdateReply(roomID string, questionID string, reply entities.Reply) (entities.Room, error)
+	RemoveReply(roomID string, questionID string, replyID string) (entities.Room, error)
+	PushPoll(roomID string, poll entities.Poll) (entities.Room, error)
+	SetPollStatus(roomID string, pollID string, status string) (entities.Room, error)
+	AddVote(roomID string, pollID string, vote entities.PollVote) (entities.Room, error)
 }
@@ -0,0 +1,10 @@
+package repositories
+
+import "github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+
+type SessionRepository interface {
+	Create(session entities.Session) (entities.Session, error)
+	FindByTokenHash(tokenHash string) (entities.Session, error)
+	MarkAsRotated(sessionID string) error
+	RevokeFamily(familyID string) error
+}
@@ -1,6 +1,10 @@
 package repositories
 
-import "github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+import (
+	"time"
+
+	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+)
 
 type UserRepository interface {
 	FindAll() ([]entities.User, error)
@@ -9,5 +13,9 @@ type UserRepository interface {
 	Update(userID string, user entities.User) (entities.User, error)
 	Delete(userID string) error
 	UpdatePassword(userID string, password string) error
+	MarkAsVerified(userID string) (entities.User, error)
 	FindByEmail(email string) (entities.User, error)
+	IncrementFailedLogins(userID string, windowStart time.Time, now time.Time) (int, error)
+	LockUntil(userID string, lockedUntil time.Time) error
+	ResetFailedLogins(userID string) error
 }
@@ -1,11 +1,18 @@
 package jwt
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
 	"github.com/golang-jwt/jwt"
 	application "github.com/waliqueiroz/letmeask-api/internal/application/errors"
+	"github.com/waliqueiroz/letmeask-api/internal/application/providers"
 	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/configurations"
 )
 
+const tokenIDSize = 16
+
 type JwtProvider struct {
 	configuration configurations.Configuration
 }
@@ -16,11 +23,18 @@ func NewJwtProvider(configuration configurations.Configuration) *JwtProvider {
 	}
 }
 
-func (provider *JwtProvider) CreateToken(userID string, expiresIn int64) (string, error) {
+func (provider *JwtProvider) CreateToken(userID string, generation int, expiresIn int64) (string, error) {
+	tokenID, err := provider.generateTokenID()
+	if err != nil {
+		return "", err
+	}
+
 	claims := jwt.MapClaims{}
 	claims["authorized"] = true
 	claims["exp"] = expiresIn
+	claims["jti"] = tokenID
 	claims["userID"] = userID
+	claims["generation"] = generation
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
@@ -28,22 +42,63 @@ func (provider *JwtProvider) CreateToken(userID string, expiresIn int64) (string
 }
 
 func (provider *JwtProvider) ExtractUserID(token interface{}) (string, error) {
+	claims, err := provider.ExtractClaims(token)
+	if err != nil {
+		return "", err
+	}
+
+	return claims.UserID, nil
+}
+
+func (provider *JwtProvider) ExtractClaims(token interface{}) (providers.TokenClaims, error) {
 	err := application.NewUnauthorizedError("token inválido")
 
 	jwtToken, ok := token.(*jwt.Token)
 	if !ok {
-		return "", err
+		return providers.TokenClaims{}, err
 	}
 
 	claims, ok := jwtToken.Claims.(jwt.MapClaims)
 	if !ok || !jwtToken.Valid {
-		return "", err
+		return providers.TokenClaims{}, err
 	}
 
 	userID, ok := claims["userID"].(string)
 	if !ok {
+		return providers.TokenClaims{}, err
+	}
+
+	tokenID, ok := claims["jti"].(string)
+	if !ok {
+		return providers.TokenClaims{}, err
+	}
+
+	// Numeric claims come back from the JSON payload as float64.
+	generation, ok := claims["generation"].(float64)
+	if !ok {
+		return providers.TokenClaims{}, err
+	}
+
+	expiresIn, ok := claims["exp"].(float64)
+	if !ok {
+		return providers.TokenClaims{}, err
+	}
+
+	return providers.TokenClaims{
+		ID:         tokenID,
+		UserID:     userID,
+		Generation: int(generation),
+		ExpiresAt:  time.Unix(int64(expiresIn), 0),
+	}, nil
+}
+
+func (provider *JwtProvider) generateTokenID() (string, error) {
+	tokenID := make([]byte, tokenIDSize)
+
+	_, err := rand.Read(tokenID)
+	if err != nil {
 		return "", err
 	}
 
-	return userID, nil
+	return hex.EncodeToString(tokenID), nil
 }
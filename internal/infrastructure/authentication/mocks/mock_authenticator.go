@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	providers "github.com/waliqueiroz/letmeask-api/internal/application/providers"
 )
 
 // MockAuthenticator is a mock of Authenticator interface.
@@ -34,18 +35,33 @@ func (m *MockAuthenticator) EXPECT() *MockAuthenticatorMockRecorder {
 }
 
 // CreateToken mocks base method.
-func (m *MockAuthenticator) CreateToken(arg0 string, arg1 int64) (string, error) {
+func (m *MockAuthenticator) CreateToken(arg0 string, arg1 int, arg2 int64) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateToken", arg0, arg1)
+	ret := m.ctrl.Call(m, "CreateToken", arg0, arg1, arg2)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateToken indicates an expected call of CreateToken.
-func (mr *MockAuthenticatorMockRecorder) CreateToken(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockAuthenticatorMockRecorder) CreateToken(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MockAuthenticator)(nil).CreateToken), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MockAuthenticator)(nil).CreateToken), arg0, arg1, arg2)
+}
+
+// ExtractClaims mocks base method.
+func (m *MockAuthenticator) ExtractClaims(arg0 interface{}) (providers.TokenClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractClaims", arg0)
+	ret0, _ := ret[0].(providers.TokenClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtractClaims indicates an expected call of ExtractClaims.
+func (mr *MockAuthenticatorMockRecorder) ExtractClaims(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractClaims", reflect.TypeOf((*MockAuthenticator)(nil).ExtractClaims), arg0)
 }
 
 // ExtractUserID mocks base method.
@@ -0,0 +1,35 @@
+package opaque
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+const tokenSize = 32
+
+type OpaqueTokenProvider struct{}
+
+func NewOpaqueTokenProvider() *OpaqueTokenProvider {
+	return &OpaqueTokenProvider{}
+}
+
+func (provider *OpaqueTokenProvider) Generate() (string, error) {
+	token := make([]byte, tokenSize)
+
+	_, err := rand.Read(token)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// Hash gives the value stored in place of the token, so a leaked database
+// does not hand out usable tokens.
+func (provider *OpaqueTokenProvider) Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,13 @@
+package hub
+
+import "time"
+
+const (
+	SubscriberBufferSize = subscriberBufferSize
+	HistorySize          = historySize
+	IdleTimeout          = idleTimeout
+)
+
+func (provider *HubProvider) SetClock(now func() time.Time) {
+	provider.now = now
+}
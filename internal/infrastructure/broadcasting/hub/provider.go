@@ -0,0 +1,183 @@
+package hub
+
+import (
+	"sync"
+	"time"
+
+	"github.com/waliqueiroz/letmeask-api/internal/application/dtos"
+)
+
+const (
+	// subscriberBufferSize is how many events a subscriber may fall behind
+	// before it is considered too slow and gets disconnected.
+	subscriberBufferSize = 64
+
+	// historySize is how many of the latest events are kept per room so
+	// reconnecting clients can catch up on what they missed.
+	historySize = 256
+
+	// idleTimeout is how long a room nobody follows keeps its history. A
+	// client coming back later starts over from a snapshot.
+	idleTimeout = 10 * time.Minute
+
+	// sweepInterval is how often the rooms that went idle are forgotten.
+	sweepInterval = time.Minute
+)
+
+type subscriber struct {
+	events chan dtos.RoomEventDTO
+	once   sync.Once
+}
+
+func (s *subscriber) close() {
+	s.once.Do(func() {
+		close(s.events)
+	})
+}
+
+type room struct {
+	sequence    int64
+	history     []dtos.RoomEventDTO
+	subscribers map[*subscriber]struct{}
+	activeAt    time.Time
+}
+
+type HubProvider struct {
+	mutex sync.Mutex
+	rooms map[string]*room
+	// lastID is the highest event ID handed out so far. A room that was
+	// forgotten numbers its events from there, so a client still holding an
+	// ID from before can never take it for a recent one.
+	lastID  int64
+	sweptAt time.Time
+	now     func() time.Time
+}
+
+func NewHubProvider() *HubProvider {
+	return &HubProvider{
+		rooms:   make(map[string]*room),
+		sweptAt: time.Now(),
+		now:     time.Now,
+	}
+}
+
+func (provider *HubProvider) Publish(event dtos.RoomEventDTO) {
+	provider.mutex.Lock()
+	defer provider.mutex.Unlock()
+
+	now := provider.now()
+	provider.sweep(now)
+
+	r := provider.room(event.RoomID)
+	r.activeAt = now
+
+	r.sequence++
+	event.ID = r.sequence
+	provider.lastID = r.sequence
+
+	r.history = append(r.history, event)
+	if len(r.history) > historySize {
+		r.history = r.history[len(r.history)-historySize:]
+	}
+
+	for s := range r.subscribers {
+		select {
+		case s.events <- event:
+		default:
+			delete(r.subscribers, s)
+			s.close()
+		}
+	}
+}
+
+func (provider *HubProvider) Subscribe(roomID string) (<-chan dtos.RoomEventDTO, func()) {
+	s := &subscriber{
+		events: make(chan dtos.RoomEventDTO, subscriberBufferSize),
+	}
+
+	provider.mutex.Lock()
+	now := provider.now()
+	provider.sweep(now)
+
+	r := provider.room(roomID)
+	r.subscribers[s] = struct{}{}
+	r.activeAt = now
+	provider.mutex.Unlock()
+
+	return s.events, func() {
+		provider.unsubscribe(roomID, s)
+	}
+}
+
+func (provider *HubProvider) History(roomID string, lastEventID int64) ([]dtos.RoomEventDTO, bool) {
+	provider.mutex.Lock()
+	defer provider.mutex.Unlock()
+
+	r, ok := provider.rooms[roomID]
+	if !ok || lastEventID > r.sequence {
+		return nil, false
+	}
+
+	if lastEventID == r.sequence {
+		return []dtos.RoomEventDTO{}, true
+	}
+
+	if len(r.history) == 0 || lastEventID < r.history[0].ID-1 {
+		return nil, false
+	}
+
+	missed := r.history[lastEventID-r.history[0].ID+1:]
+
+	return append([]dtos.RoomEventDTO{}, missed...), true
+}
+
+func (provider *HubProvider) room(roomID string) *room {
+	r, ok := provider.rooms[roomID]
+	if !ok {
+		r = &room{
+			sequence:    provider.lastID,
+			subscribers: make(map[*subscriber]struct{}),
+		}
+		provider.rooms[roomID] = r
+	}
+
+	return r
+}
+
+func (provider *HubProvider) unsubscribe(roomID string, s *subscriber) {
+	provider.mutex.Lock()
+	defer provider.mutex.Unlock()
+
+	r, ok := provider.rooms[roomID]
+	if !ok {
+		return
+	}
+
+	if _, ok := r.subscribers[s]; ok {
+		delete(r.subscribers, s)
+		s.close()
+	}
+
+	r.activeAt = provider.now()
+
+	// A room nothing was published to has nothing to catch up on either, so
+	// it is forgotten once its last subscriber leaves.
+	if len(r.subscribers) == 0 && len(r.history) == 0 {
+		delete(provider.rooms, roomID)
+	}
+}
+
+// sweep forgets the rooms nobody followed or published to for idleTimeout.
+func (provider *HubProvider) sweep(now time.Time) {
+	if now.Sub(provider.sweptAt) < sweepInterval {
+		return
+	}
+
+	for roomID, r := range provider.rooms {
+		if len(r.subscribers) == 0 && now.Sub(r.activeAt) >= idleTimeout {
+			delete(provider.rooms, roomID)
+		}
+	}
+
+	provider.sweptAt = now
+}
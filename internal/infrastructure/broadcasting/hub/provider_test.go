@@ -0,0 +1,193 @@
+package hub_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/waliqueiroz/letmeask-api/internal/application/dtos"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/broadcasting/hub"
+)
+
+const roomID = "621f5ec1e07fdbb81c8221f7"
+
+var _ = Describe("HubProvider", func() {
+	var provider *hub.HubProvider
+	var now time.Time
+
+	publish := func(roomID string, count int) {
+		for i := 0; i < count; i++ {
+			provider.Publish(dtos.RoomEventDTO{Type: "question_created", RoomID: roomID})
+		}
+	}
+
+	BeforeEach(func() {
+		now = time.Now()
+		provider = hub.NewHubProvider()
+		provider.SetClock(func() time.Time {
+			return now
+		})
+	})
+
+	Describe("Publish", func() {
+		When("a subscriber keeps up", func() {
+			It("should deliver the events numbered in order", func() {
+				events, unsubscribe := provider.Subscribe(roomID)
+				defer unsubscribe()
+
+				publish(roomID, 2)
+
+				Expect((<-events).ID).Should(Equal(int64(1)))
+				Expect((<-events).ID).Should(Equal(int64(2)))
+			})
+		})
+
+		When("a subscriber falls more than its buffer behind", func() {
+			It("should drop the subscriber and close its channel", func() {
+				events, unsubscribe := provider.Subscribe(roomID)
+				defer unsubscribe()
+
+				publish(roomID, hub.SubscriberBufferSize+1)
+
+				for i := 0; i < hub.SubscriberBufferSize; i++ {
+					Expect((<-events).ID).Should(Equal(int64(i + 1)))
+				}
+
+				_, open := <-events
+				Expect(open).Should(BeFalse())
+			})
+
+			It("should keep delivering to the other subscribers", func() {
+				_, unsubscribeSlow := provider.Subscribe(roomID)
+				defer unsubscribeSlow()
+
+				events, unsubscribe := provider.Subscribe(roomID)
+				defer unsubscribe()
+
+				for i := 0; i < hub.SubscriberBufferSize+1; i++ {
+					publish(roomID, 1)
+					Expect((<-events).ID).Should(Equal(int64(i + 1)))
+				}
+			})
+		})
+	})
+
+	Describe("History", func() {
+		When("the room is unknown", func() {
+			It("should not be able to catch up", func() {
+				_, ok := provider.History(roomID, 0)
+
+				Expect(ok).Should(BeFalse())
+			})
+		})
+
+		When("the client has seen the latest event", func() {
+			It("should return no events", func() {
+				publish(roomID, 3)
+
+				missed, ok := provider.History(roomID, 3)
+
+				Expect(ok).Should(BeTrue())
+				Expect(missed).Should(BeEmpty())
+			})
+		})
+
+		When("the client missed some events", func() {
+			It("should return the events after the last one it saw", func() {
+				publish(roomID, 5)
+
+				missed, ok := provider.History(roomID, 2)
+
+				Expect(ok).Should(BeTrue())
+				Expect(missed).Should(HaveLen(3))
+				Expect(missed[0].ID).Should(Equal(int64(3)))
+				Expect(missed[2].ID).Should(Equal(int64(5)))
+			})
+		})
+
+		When("the client claims an event that was never published", func() {
+			It("should not be able to catch up", func() {
+				publish(roomID, 3)
+
+				_, ok := provider.History(roomID, 4)
+
+				Expect(ok).Should(BeFalse())
+			})
+		})
+
+		When("the history has been trimmed", func() {
+			BeforeEach(func() {
+				publish(roomID, hub.HistorySize+10)
+			})
+
+			It("should return the whole history if the client saw the event right before it", func() {
+				missed, ok := provider.History(roomID, 10)
+
+				Expect(ok).Should(BeTrue())
+				Expect(missed).Should(HaveLen(hub.HistorySize))
+				Expect(missed[0].ID).Should(Equal(int64(11)))
+			})
+
+			It("should not be able to catch up if the client missed trimmed events", func() {
+				_, ok := provider.History(roomID, 9)
+
+				Expect(ok).Should(BeFalse())
+			})
+		})
+	})
+
+	Describe("Eviction", func() {
+		When("nobody follows or publishes to a room for the idle timeout", func() {
+			BeforeEach(func() {
+				publish(roomID, 3)
+				now = now.Add(hub.IdleTimeout)
+				publish("621f5ec1e07fdbb81c8221f8", 1)
+			})
+
+			It("should forget the room history", func() {
+				_, ok := provider.History(roomID, 3)
+
+				Expect(ok).Should(BeFalse())
+			})
+
+			It("should not reuse the event IDs of the forgotten room", func() {
+				publish(roomID, 5)
+
+				_, ok := provider.History(roomID, 3)
+
+				Expect(ok).Should(BeFalse())
+			})
+		})
+
+		When("a room is still followed", func() {
+			It("should keep the room history", func() {
+				_, unsubscribe := provider.Subscribe(roomID)
+				defer unsubscribe()
+
+				publish(roomID, 3)
+				now = now.Add(hub.IdleTimeout)
+				publish("621f5ec1e07fdbb81c8221f8", 1)
+
+				missed, ok := provider.History(roomID, 2)
+
+				Expect(ok).Should(BeTrue())
+				Expect(missed).Should(HaveLen(1))
+			})
+		})
+
+		When("the last subscriber left recently", func() {
+			It("should keep the room history", func() {
+				_, unsubscribe := provider.Subscribe(roomID)
+				publish(roomID, 3)
+				now = now.Add(hub.IdleTimeout / 2)
+				unsubscribe()
+				now = now.Add(hub.IdleTimeout / 2)
+				publish("621f5ec1e07fdbb81c8221f8", 1)
+
+				_, ok := provider.History(roomID, 3)
+
+				Expect(ok).Should(BeTrue())
+			})
+		})
+	})
+})
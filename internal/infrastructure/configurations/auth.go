@@ -1,5 +1,19 @@
 package configurations
 
+import "time"
+
 type Auth struct {
-	SecretKey string `env:"SECRET_KEY"`
+	SecretKey            string        `env:"SECRET_KEY"`
+	AccessTokenTTL       time.Duration `env:"ACCESS_TOKEN_TTL" envDefault:"15m"`
+	RefreshTokenTTL      time.Duration `env:"REFRESH_TOKEN_TTL" envDefault:"720h"`
+	RevocationStore      string        `env:"REVOCATION_STORE" envDefault:"mongodb"`
+	ResetTokenTTL        time.Duration `env:"PASSWORD_RESET_TTL" envDefault:"1h"`
+	ResetURL             string        `env:"PASSWORD_RESET_URL"`
+	VerificationTTL      time.Duration `env:"EMAIL_VERIFICATION_TTL" envDefault:"48h"`
+	VerificationURL      string        `env:"EMAIL_VERIFICATION_URL"`
+	RequireVerifiedEmail bool          `env:"REQUIRE_VERIFIED_EMAIL" envDefault:"false"`
+	LockoutThreshold     int           `env:"LOGIN_LOCKOUT_THRESHOLD" envDefault:"5"`
+	LockoutDuration      time.Duration `env:"LOGIN_LOCKOUT_DURATION" envDefault:"1m"`
+	MaxLockoutDuration   time.Duration `env:"LOGIN_MAX_LOCKOUT_DURATION" envDefault:"1h"`
+	FailureWindow        time.Duration `env:"LOGIN_FAILURE_WINDOW" envDefault:"1h"`
 }
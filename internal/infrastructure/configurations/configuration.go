@@ -1,6 +1,9 @@
 package configurations
 
 type Configuration struct {
-	Database Database
-	Auth     Auth
+	Database  Database
+	Auth      Auth
+	Mail      Mail
+	Room      Room
+	RateLimit RateLimit
 }
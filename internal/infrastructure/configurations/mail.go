@@ -0,0 +1,10 @@
+package configurations
+
+type Mail struct {
+	Driver   string `env:"MAIL_DRIVER" envDefault:"smtp"`
+	Host     string `env:"MAIL_HOST"`
+	Port     string `env:"MAIL_PORT" envDefault:"25"`
+	Username string `env:"MAIL_USERNAME"`
+	Password string `env:"MAIL_PASSWORD"`
+	From     string `env:"MAIL_FROM"`
+}
@@ -0,0 +1,12 @@
+package configurations
+
+import "time"
+
+type RateLimit struct {
+	Store         string        `env:"RATE_LIMIT_STORE" envDefault:"mongodb"`
+	Requests      int           `env:"RATE_LIMIT_REQUESTS" envDefault:"300"`
+	Period        time.Duration `env:"RATE_LIMIT_PERIOD" envDefault:"1m"`
+	LoginRequests int           `env:"LOGIN_RATE_LIMIT_REQUESTS" envDefault:"10"`
+	LoginPeriod   time.Duration `env:"LOGIN_RATE_LIMIT_PERIOD" envDefault:"15m"`
+	ProxyHeader   string        `env:"PROXY_HEADER"`
+}
@@ -0,0 +1,10 @@
+package configurations
+
+import "time"
+
+type Room struct {
+	QuestionEditWindow time.Duration `env:"QUESTION_EDIT_WINDOW" envDefault:"5m"`
+	AnswerGracePeriod  time.Duration `env:"ANSWER_GRACE_PERIOD" envDefault:"15m"`
+	AccessGrantTTL     time.Duration `env:"ROOM_ACCESS_GRANT_TTL" envDefault:"12h"`
+	SchedulerInterval  time.Duration `env:"ROOM_SCHEDULER_INTERVAL" envDefault:"30s"`
+}
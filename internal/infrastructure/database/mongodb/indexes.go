@@ -0,0 +1,52 @@
+package mongodb
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var indexes = map[string][]mongo.IndexModel{
+	"rooms": {
+		{Keys: bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "author._id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "ended_at", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "title", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "title", Value: "text"}}},
+		{Keys: bson.D{{Key: "code", Value: 1}}, Options: options.Index().SetUnique(true).SetSparse(true)},
+		{Keys: bson.D{{Key: "scheduled_end_at", Value: 1}}, Options: options.Index().SetSparse(true)},
+	},
+	"sessions": {
+		{Keys: bson.D{{Key: "token_hash", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "family_id", Value: 1}}},
+		{Keys: bson.D{{Key: "expires_at", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+	},
+	"password_resets": {
+		{Keys: bson.D{{Key: "token_hash", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "expires_at", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+	},
+	"revoked_tokens": {
+		{Keys: bson.D{{Key: "expires_at", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+	},
+	"rate_limits": {
+		{Keys: bson.D{{Key: "expires_at", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+	},
+}
+
+// CreateIndexes makes sure every index the repositories rely on exists.
+// Creating an index that is already there is a no-op, so it is safe to run
+// on every start.
+func CreateIndexes(db *mongo.Database) error {
+	ctx := context.Background()
+
+	for collection, models := range indexes {
+		_, err := db.Collection(collection).Indexes().CreateMany(ctx, models)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
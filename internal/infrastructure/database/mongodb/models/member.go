@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+)
+
+type Member struct {
+	User    Author    `bson:"user"`
+	Role    string    `bson:"role"`
+	AddedAt time.Time `bson:"added_at"`
+}
+
+func (m Member) ToDomain() entities.Member {
+	return entities.Member{
+		User:    m.User.ToDomain(),
+		Role:    m.Role,
+		AddedAt: m.AddedAt,
+	}
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type PasswordReset struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UserID    primitive.ObjectID `bson:"user_id"`
+	TokenHash string             `bson:"token_hash"`
+	ExpiresAt time.Time          `bson:"expires_at"`
+	UsedAt    *time.Time         `bson:"used_at,omitempty"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+func (r PasswordReset) ToDomain() entities.PasswordReset {
+	return entities.PasswordReset{
+		ID:        r.ID.Hex(),
+		UserID:    r.UserID.Hex(),
+		TokenHash: r.TokenHash,
+		ExpiresAt: r.ExpiresAt,
+		UsedAt:    r.UsedAt,
+		CreatedAt: r.CreatedAt,
+	}
+}
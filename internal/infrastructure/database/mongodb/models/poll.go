@@ -0,0 +1,69 @@
+package models
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Poll struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	Question       string             `bson:"question"`
+	Options        []PollOption       `bson:"options"`
+	MultipleChoice bool               `bson:"multiple_choice"`
+	Status         string             `bson:"status"`
+	Votes          []PollVote         `bson:"votes,omitempty"`
+	CreatedAt      time.Time          `bson:"created_at"`
+	ClosedAt       *time.Time         `bson:"closed_at,omitempty"`
+}
+
+type PollOption struct {
+	ID   primitive.ObjectID `bson:"_id"`
+	Text string             `bson:"text"`
+}
+
+type PollVote struct {
+	UserID    primitive.ObjectID   `bson:"user_id"`
+	OptionIDs []primitive.ObjectID `bson:"option_ids"`
+	CreatedAt time.Time            `bson:"created_at"`
+}
+
+func (p Poll) ToDomain() entities.Poll {
+	var options []entities.PollOption
+	for _, option := range p.Options {
+		options = append(options, entities.PollOption{
+			ID:   option.ID.Hex(),
+			Text: option.Text,
+		})
+	}
+
+	var votes []entities.PollVote
+	for _, vote := range p.Votes {
+		var optionIDs []string
+		for _, optionID := range vote.OptionIDs {
+			optionIDs = append(optionIDs, optionID.Hex())
+		}
+
+		votes = append(votes, entities.PollVote{
+			UserID:    vote.UserID.Hex(),
+			OptionIDs: optionIDs,
+			CreatedAt: vote.CreatedAt,
+		})
+	}
+
+	poll := entities.Poll{
+		ID:             p.ID.Hex(),
+		Question:       p.Question,
+		Options:        options,
+		MultipleChoice: p.MultipleChoice,
+		Status:         p.Status,
+		Votes:          votes,
+		CreatedAt:      p.CreatedAt,
+		ClosedAt:       p.ClosedAt,
+	}
+
+	poll.Tally()
+
+	return poll
+}
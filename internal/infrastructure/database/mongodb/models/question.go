@@ -12,9 +12,27 @@ type Question struct {
 	Content       string             `bson:"content"`
 	IsHighlighted bool               `bson:"is_highlighted"`
 	IsAnswered    bool               `bson:"is_answered"`
+	IsAnonymous   bool               `bson:"is_anonymous"`
+	Status        string             `bson:"status,omitempty"`
 	Author        Author             `bson:"author"`
 	Likes         []Like             `bson:"likes,omitempty"`
+	Replies       []Reply            `bson:"replies,omitempty"`
+	AnswerID      primitive.ObjectID `bson:"answer_id,omitempty"`
+	Revisions     []QuestionRevision `bson:"revisions,omitempty"`
 	CreatedAt     time.Time          `bson:"created_at"`
+	EditedAt      *time.Time         `bson:"edited_at,omitempty"`
+	Deletion      *QuestionDeletion  `bson:"deletion,omitempty"`
+}
+
+type QuestionDeletion struct {
+	Reason    string             `bson:"reason"`
+	DeletedBy primitive.ObjectID `bson:"deleted_by"`
+	DeletedAt time.Time          `bson:"deleted_at"`
+}
+
+type QuestionRevision struct {
+	Content   string    `bson:"content"`
+	CreatedAt time.Time `bson:"created_at"`
 }
 
 func (q Question) ToDomain() entities.Question {
@@ -23,13 +41,47 @@ func (q Question) ToDomain() entities.Question {
 		likes = append(likes, like.ToDomain())
 	}
 
+	var replies []entities.Reply
+	for _, reply := range q.Replies {
+		replies = append(replies, reply.ToDomain())
+	}
+
+	var revisions []entities.QuestionRevision
+	for _, revision := range q.Revisions {
+		revisions = append(revisions, entities.QuestionRevision{
+			Content:   revision.Content,
+			CreatedAt: revision.CreatedAt,
+		})
+	}
+
+	var deletion *entities.QuestionDeletion
+	if q.Deletion != nil {
+		deletion = &entities.QuestionDeletion{
+			Reason:    q.Deletion.Reason,
+			DeletedBy: q.Deletion.DeletedBy.Hex(),
+			DeletedAt: q.Deletion.DeletedAt,
+		}
+	}
+
+	var answerID string
+	if !q.AnswerID.IsZero() {
+		answerID = q.AnswerID.Hex()
+	}
+
 	return entities.Question{
 		ID:            q.ID.Hex(),
 		Content:       q.Content,
 		IsHighlighted: q.IsHighlighted,
 		IsAnswered:    q.IsAnswered,
+		IsAnonymous:   q.IsAnonymous,
+		Status:        q.Status,
 		Author:        q.Author.ToDomain(),
 		Likes:         likes,
+		Replies:       replies,
+		AnswerID:      answerID,
+		Revisions:     revisions,
 		CreatedAt:     q.CreatedAt,
+		EditedAt:      q.EditedAt,
+		Deletion:      deletion,
 	}
 }
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Reply struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	Content    string             `bson:"content"`
+	Author     Author             `bson:"author"`
+	IsOfficial bool               `bson:"is_official"`
+	CreatedAt  time.Time          `bson:"created_at"`
+	UpdatedAt  *time.Time         `bson:"updated_at,omitempty"`
+}
+
+func (r Reply) ToDomain() entities.Reply {
+	return entities.Reply{
+		ID:         r.ID.Hex(),
+		Content:    r.Content,
+		Author:     r.Author.ToDomain(),
+		IsOfficial: r.IsOfficial,
+		CreatedAt:  r.CreatedAt,
+		UpdatedAt:  r.UpdatedAt,
+	}
+}
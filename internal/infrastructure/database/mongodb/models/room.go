@@ -8,13 +8,21 @@ import (
 )
 
 type Room struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty"`
-	Title     string             `bson:"title"`
-	Questions []Question         `bson:"questions,omitempty"`
-	Author    Author             `bson:"author"`
-	EndedAt   *time.Time         `bson:"ended_at,omitempty"`
-	CreatedAt time.Time          `bson:"created_at"`
-	UpdatedAt time.Time          `bson:"updated_at"`
+	ID               primitive.ObjectID `bson:"_id,omitempty"`
+	Code             string             `bson:"code,omitempty"`
+	Title            string             `bson:"title"`
+	Questions        []Question         `bson:"questions,omitempty"`
+	QuestionCount    int                `bson:"question_count,omitempty"`
+	Author           Author             `bson:"author"`
+	Members          []Member           `bson:"members,omitempty"`
+	Polls            []Poll             `bson:"polls,omitempty"`
+	Settings         RoomSettings       `bson:"settings"`
+	ScheduledStartAt *time.Time         `bson:"scheduled_start_at,omitempty"`
+	ScheduledEndAt   *time.Time         `bson:"scheduled_end_at,omitempty"`
+	EndedAt          *time.Time         `bson:"ended_at,omitempty"`
+	Version          int64              `bson:"version"`
+	CreatedAt        time.Time          `bson:"created_at"`
+	UpdatedAt        time.Time          `bson:"updated_at"`
 }
 
 func (r Room) ToDomain() entities.Room {
@@ -23,13 +31,31 @@ func (r Room) ToDomain() entities.Room {
 		questions = append(questions, question.ToDomain())
 	}
 
+	var members []entities.Member
+	for _, member := range r.Members {
+		members = append(members, member.ToDomain())
+	}
+
+	var polls []entities.Poll
+	for _, poll := range r.Polls {
+		polls = append(polls, poll.ToDomain())
+	}
+
 	return entities.Room{
-		ID:        r.ID.Hex(),
-		Title:     r.Title,
-		Questions: questions,
-		Author:    r.Author.ToDomain(),
-		EndedAt:   r.EndedAt,
-		CreatedAt: r.CreatedAt,
-		UpdatedAt: r.UpdatedAt,
+		ID:               r.ID.Hex(),
+		Code:             r.Code,
+		Title:            r.Title,
+		Questions:        questions,
+		QuestionCount:    r.QuestionCount,
+		Author:           r.Author.ToDomain(),
+		Members:          members,
+		Polls:            polls,
+		Settings:         r.Settings.ToDomain(),
+		ScheduledStartAt: r.ScheduledStartAt,
+		ScheduledEndAt:   r.ScheduledEndAt,
+		EndedAt:          r.EndedAt,
+		Version:          r.Version,
+		CreatedAt:        r.CreatedAt,
+		UpdatedAt:        r.UpdatedAt,
 	}
 }
@@ -0,0 +1,29 @@
+package models
+
+import "github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+
+type RoomSettings struct {
+	AllowAnonymousQuestions    bool     `bson:"allow_anonymous_questions"`
+	RequireApproval            bool     `bson:"require_approval"`
+	Visibility                 string   `bson:"visibility,omitempty"`
+	Passcode                   string   `bson:"passcode,omitempty"`
+	InvitedUserIDs             []string `bson:"invited_user_ids,omitempty"`
+	InvitedDomains             []string `bson:"invited_domains,omitempty"`
+	MaxQuestionsPerUser        int      `bson:"max_questions_per_user,omitempty"`
+	MinSecondsBetweenQuestions int      `bson:"min_seconds_between_questions,omitempty"`
+	MaxOpenQuestionsPerUser    int      `bson:"max_open_questions_per_user,omitempty"`
+}
+
+func (s RoomSettings) ToDomain() entities.RoomSettings {
+	return entities.RoomSettings{
+		AllowAnonymousQuestions:    s.AllowAnonymousQuestions,
+		RequireApproval:            s.RequireApproval,
+		Visibility:                 s.Visibility,
+		Passcode:                   s.Passcode,
+		InvitedUserIDs:             s.InvitedUserIDs,
+		InvitedDomains:             s.InvitedDomains,
+		MaxQuestionsPerUser:        s.MaxQuestionsPerUser,
+		MinSecondsBetweenQuestions: s.MinSecondsBetweenQuestions,
+		MaxOpenQuestionsPerUser:    s.MaxOpenQuestionsPerUser,
+	}
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Session struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty"`
+	UserID          primitive.ObjectID `bson:"user_id"`
+	FamilyID        primitive.ObjectID `bson:"family_id"`
+	TokenHash       string             `bson:"token_hash"`
+	TokenGeneration int                `bson:"token_generation"`
+	ExpiresAt       time.Time          `bson:"expires_at"`
+	RotatedAt       *time.Time         `bson:"rotated_at,omitempty"`
+	RevokedAt       *time.Time         `bson:"revoked_at,omitempty"`
+	CreatedAt       time.Time          `bson:"created_at"`
+}
+
+func (s Session) ToDomain() entities.Session {
+	return entities.Session{
+		ID:              s.ID.Hex(),
+		UserID:          s.UserID.Hex(),
+		FamilyID:        s.FamilyID.Hex(),
+		TokenHash:       s.TokenHash,
+		TokenGeneration: s.TokenGeneration,
+		ExpiresAt:       s.ExpiresAt,
+		RotatedAt:       s.RotatedAt,
+		RevokedAt:       s.RevokedAt,
+		CreatedAt:       s.CreatedAt,
+	}
+}
@@ -8,23 +8,33 @@ import (
 )
 
 type User struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty"`
-	Name      string             `bson:"name"`
-	Avatar    string             `bson:"avatar"`
-	Email     string             `bson:"email"`
-	Password  string             `bson:"password"`
-	CreatedAt time.Time          `bson:"created_at"`
-	UpdatedAt time.Time          `bson:"updated_at"`
+	ID              primitive.ObjectID `bson:"_id,omitempty"`
+	Name            string             `bson:"name"`
+	Avatar          string             `bson:"avatar"`
+	Email           string             `bson:"email"`
+	Password        string             `bson:"password"`
+	Role            string             `bson:"role"`
+	TokenGeneration int                `bson:"token_generation"`
+	FailedLogins    int                `bson:"failed_logins,omitempty"`
+	LockedUntil     *time.Time         `bson:"locked_until,omitempty"`
+	VerifiedAt      *time.Time         `bson:"verified_at,omitempty"`
+	CreatedAt       time.Time          `bson:"created_at"`
+	UpdatedAt       time.Time          `bson:"updated_at"`
 }
 
 func (u User) ToDomain() entities.User {
 	return entities.User{
-		ID:        u.ID.Hex(),
-		Name:      u.Name,
-		Avatar:    u.Avatar,
-		Email:     u.Email,
-		Password:  u.Password,
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
+		ID:              u.ID.Hex(),
+		Name:            u.Name,
+		Avatar:          u.Avatar,
+		Email:           u.Email,
+		Password:        u.Password,
+		Role:            u.Role,
+		TokenGeneration: u.TokenGeneration,
+		FailedLogins:    u.FailedLogins,
+		LockedUntil:     u.LockedUntil,
+		VerifiedAt:      u.VerifiedAt,
+		CreatedAt:       u.CreatedAt,
+		UpdatedAt:       u.UpdatedAt,
 	}
 }
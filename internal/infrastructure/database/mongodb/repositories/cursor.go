@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"encoding/base64"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// cursor points at the last document of a page: the value of the sort field
+// plus the _id used to break ties between documents sharing that value.
+type cursor struct {
+	Value interface{}        `bson:"value"`
+	ID    primitive.ObjectID `bson:"id"`
+}
+
+func encodeCursor(value interface{}, id primitive.ObjectID) (string, error) {
+	data, err := bson.Marshal(cursor{Value: value, ID: id})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeCursor(encoded string) (cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return cursor{}, err
+	}
+
+	var decoded cursor
+	if err := bson.Unmarshal(data, &decoded); err != nil {
+		return cursor{}, err
+	}
+
+	return decoded, nil
+}
+
+// afterCursor builds the keyset condition that skips everything up to and
+// including the document the cursor points at.
+func afterCursor(sortField string, descending bool, idDescending bool, position cursor) bson.M {
+	return bson.M{"$or": []bson.M{
+		{sortField: bson.M{comparison(descending): position.Value}},
+		{sortField: position.Value, "_id": bson.M{comparison(idDescending): position.ID}},
+	}}
+}
+
+func comparison(descending bool) string {
+	if descending {
+		return "$lt"
+	}
+
+	return "$gt"
+}
+
+func direction(descending bool) int {
+	if descending {
+		return -1
+	}
+
+	return 1
+}
@@ -0,0 +1,10 @@
+package repositories
+
+import (
+	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func RoomUpdate(room entities.Room) (bson.M, error) {
+	return (&RoomRepository{}).roomUpdate(room)
+}
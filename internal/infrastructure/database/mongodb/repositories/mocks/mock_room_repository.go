@@ -6,9 +6,11 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	entities "github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+	repositories "github.com/waliqueiroz/letmeask-api/internal/domain/repositories"
 )
 
 // MockRoomRepository is a mock of RoomRepository interface.
@@ -34,6 +36,51 @@ func (m *MockRoomRepository) EXPECT() *MockRoomRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddLike mocks base method.
+func (m *MockRoomRepository) AddLike(arg0, arg1 string, arg2 entities.Like) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddLike", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddLike indicates an expected call of AddLike.
+func (mr *MockRoomRepositoryMockRecorder) AddLike(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLike", reflect.TypeOf((*MockRoomRepository)(nil).AddLike), arg0, arg1, arg2)
+}
+
+// AddReply mocks base method.
+func (m *MockRoomRepository) AddReply(arg0, arg1 string, arg2 entities.Reply) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReply", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReply indicates an expected call of AddReply.
+func (mr *MockRoomRepositoryMockRecorder) AddReply(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReply", reflect.TypeOf((*MockRoomRepository)(nil).AddReply), arg0, arg1, arg2)
+}
+
+// AddVote mocks base method.
+func (m *MockRoomRepository) AddVote(arg0, arg1 string, arg2 entities.PollVote) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddVote", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddVote indicates an expected call of AddVote.
+func (mr *MockRoomRepositoryMockRecorder) AddVote(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVote", reflect.TypeOf((*MockRoomRepository)(nil).AddVote), arg0, arg1, arg2)
+}
+
 // Create mocks base method.
 func (m *MockRoomRepository) Create(arg0 entities.Room) (entities.Room, error) {
 	m.ctrl.T.Helper()
@@ -49,6 +96,36 @@ func (mr *MockRoomRepositoryMockRecorder) Create(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoomRepository)(nil).Create), arg0)
 }
 
+// FindAll mocks base method.
+func (m *MockRoomRepository) FindAll(arg0 repositories.RoomFilter) (repositories.RoomPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0)
+	ret0, _ := ret[0].(repositories.RoomPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockRoomRepositoryMockRecorder) FindAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockRoomRepository)(nil).FindAll), arg0)
+}
+
+// FindByCode mocks base method.
+func (m *MockRoomRepository) FindByCode(arg0 string) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCode", arg0)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCode indicates an expected call of FindByCode.
+func (mr *MockRoomRepositoryMockRecorder) FindByCode(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCode", reflect.TypeOf((*MockRoomRepository)(nil).FindByCode), arg0)
+}
+
 // FindByID mocks base method.
 func (m *MockRoomRepository) FindByID(arg0 string) (entities.Room, error) {
 	m.ctrl.T.Helper()
@@ -64,6 +141,186 @@ func (mr *MockRoomRepositoryMockRecorder) FindByID(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockRoomRepository)(nil).FindByID), arg0)
 }
 
+// FindDueRooms mocks base method.
+func (m *MockRoomRepository) FindDueRooms(arg0 time.Time) ([]entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDueRooms", arg0)
+	ret0, _ := ret[0].([]entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDueRooms indicates an expected call of FindDueRooms.
+func (mr *MockRoomRepositoryMockRecorder) FindDueRooms(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDueRooms", reflect.TypeOf((*MockRoomRepository)(nil).FindDueRooms), arg0)
+}
+
+// FindQuestions mocks base method.
+func (m *MockRoomRepository) FindQuestions(arg0 string, arg1 repositories.QuestionFilter) (repositories.QuestionPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindQuestions", arg0, arg1)
+	ret0, _ := ret[0].(repositories.QuestionPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindQuestions indicates an expected call of FindQuestions.
+func (mr *MockRoomRepositoryMockRecorder) FindQuestions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindQuestions", reflect.TypeOf((*MockRoomRepository)(nil).FindQuestions), arg0, arg1)
+}
+
+// FindSummaryByID mocks base method.
+func (m *MockRoomRepository) FindSummaryByID(arg0 string) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSummaryByID", arg0)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSummaryByID indicates an expected call of FindSummaryByID.
+func (mr *MockRoomRepositoryMockRecorder) FindSummaryByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSummaryByID", reflect.TypeOf((*MockRoomRepository)(nil).FindSummaryByID), arg0)
+}
+
+// MarkQuestionAsAnswered mocks base method.
+func (m *MockRoomRepository) MarkQuestionAsAnswered(arg0, arg1, arg2 string) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkQuestionAsAnswered", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkQuestionAsAnswered indicates an expected call of MarkQuestionAsAnswered.
+func (mr *MockRoomRepositoryMockRecorder) MarkQuestionAsAnswered(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkQuestionAsAnswered", reflect.TypeOf((*MockRoomRepository)(nil).MarkQuestionAsAnswered), arg0, arg1, arg2)
+}
+
+// MarkQuestionAsDeleted mocks base method.
+func (m *MockRoomRepository) MarkQuestionAsDeleted(arg0, arg1 string, arg2 entities.QuestionDeletion) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkQuestionAsDeleted", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkQuestionAsDeleted indicates an expected call of MarkQuestionAsDeleted.
+func (mr *MockRoomRepositoryMockRecorder) MarkQuestionAsDeleted(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkQuestionAsDeleted", reflect.TypeOf((*MockRoomRepository)(nil).MarkQuestionAsDeleted), arg0, arg1, arg2)
+}
+
+// PushPoll mocks base method.
+func (m *MockRoomRepository) PushPoll(arg0 string, arg1 entities.Poll) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PushPoll", arg0, arg1)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PushPoll indicates an expected call of PushPoll.
+func (mr *MockRoomRepositoryMockRecorder) PushPoll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushPoll", reflect.TypeOf((*MockRoomRepository)(nil).PushPoll), arg0, arg1)
+}
+
+// PushQuestion mocks base method.
+func (m *MockRoomRepository) PushQuestion(arg0 string, arg1 entities.Question, arg2 bool) (entities.Question, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PushQuestion", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Question)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PushQuestion indicates an expected call of PushQuestion.
+func (mr *MockRoomRepositoryMockRecorder) PushQuestion(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushQuestion", reflect.TypeOf((*MockRoomRepository)(nil).PushQuestion), arg0, arg1, arg2)
+}
+
+// RemoveLike mocks base method.
+func (m *MockRoomRepository) RemoveLike(arg0, arg1, arg2 string) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveLike", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveLike indicates an expected call of RemoveLike.
+func (mr *MockRoomRepositoryMockRecorder) RemoveLike(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLike", reflect.TypeOf((*MockRoomRepository)(nil).RemoveLike), arg0, arg1, arg2)
+}
+
+// RemoveReply mocks base method.
+func (m *MockRoomRepository) RemoveReply(arg0, arg1, arg2 string) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReply", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveReply indicates an expected call of RemoveReply.
+func (mr *MockRoomRepositoryMockRecorder) RemoveReply(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReply", reflect.TypeOf((*MockRoomRepository)(nil).RemoveReply), arg0, arg1, arg2)
+}
+
+// SetPollStatus mocks base method.
+func (m *MockRoomRepository) SetPollStatus(arg0, arg1, arg2 string) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPollStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPollStatus indicates an expected call of SetPollStatus.
+func (mr *MockRoomRepositoryMockRecorder) SetPollStatus(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPollStatus", reflect.TypeOf((*MockRoomRepository)(nil).SetPollStatus), arg0, arg1, arg2)
+}
+
+// SetQuestionHighlight mocks base method.
+func (m *MockRoomRepository) SetQuestionHighlight(arg0, arg1 string, arg2 bool) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetQuestionHighlight", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetQuestionHighlight indicates an expected call of SetQuestionHighlight.
+func (mr *MockRoomRepositoryMockRecorder) SetQuestionHighlight(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQuestionHighlight", reflect.TypeOf((*MockRoomRepository)(nil).SetQuestionHighlight), arg0, arg1, arg2)
+}
+
+// SetQuestionStatus mocks base method.
+func (m *MockRoomRepository) SetQuestionStatus(arg0, arg1, arg2 string) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetQuestionStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetQuestionStatus indicates an expected call of SetQuestionStatus.
+func (mr *MockRoomRepositoryMockRecorder) SetQuestionStatus(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQuestionStatus", reflect.TypeOf((*MockRoomRepository)(nil).SetQuestionStatus), arg0, arg1, arg2)
+}
+
 // Update mocks base method.
 func (m *MockRoomRepository) Update(arg0 string, arg1 entities.Room) (entities.Room, error) {
 	m.ctrl.T.Helper()
@@ -78,3 +335,18 @@ func (mr *MockRoomRepositoryMockRecorder) Update(arg0, arg1 interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRoomRepository)(nil).Update), arg0, arg1)
 }
+
+// UpdateReply mocks base method.
+func (m *MockRoomRepository) UpdateReply(arg0, arg1 string, arg2 entities.Reply) (entities.Room, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReply", arg0, arg1, arg2)
+	ret0, _ := ret[0].(entities.Room)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReply indicates an expected call of UpdateReply.
+func (mr *MockRoomRepositoryMockRecorder) UpdateReply(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReply", reflect.TypeOf((*MockRoomRepository)(nil).UpdateReply), arg0, arg1, arg2)
+}
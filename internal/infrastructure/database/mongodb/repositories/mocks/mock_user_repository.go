@@ -6,6 +6,7 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	entities "github.com/waliqueiroz/letmeask-api/internal/domain/entities"
@@ -108,6 +109,64 @@ func (mr *MockUserRepositoryMockRecorder) FindByID(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockUserRepository)(nil).FindByID), arg0)
 }
 
+// IncrementFailedLogins mocks base method.
+func (m *MockUserRepository) IncrementFailedLogins(arg0 string, arg1, arg2 time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementFailedLogins", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementFailedLogins indicates an expected call of IncrementFailedLogins.
+func (mr *MockUserRepositoryMockRecorder) IncrementFailedLogins(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementFailedLogins", reflect.TypeOf((*MockUserRepository)(nil).IncrementFailedLogins), arg0, arg1, arg2)
+}
+
+// LockUntil mocks base method.
+func (m *MockUserRepository) LockUntil(arg0 string, arg1 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUntil", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockUntil indicates an expected call of LockUntil.
+func (mr *MockUserRepositoryMockRecorder) LockUntil(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUntil", reflect.TypeOf((*MockUserRepository)(nil).LockUntil), arg0, arg1)
+}
+
+// MarkAsVerified mocks base method.
+func (m *MockUserRepository) MarkAsVerified(arg0 string) (entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsVerified", arg0)
+	ret0, _ := ret[0].(entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAsVerified indicates an expected call of MarkAsVerified.
+func (mr *MockUserRepositoryMockRecorder) MarkAsVerified(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsVerified", reflect.TypeOf((*MockUserRepository)(nil).MarkAsVerified), arg0)
+}
+
+// ResetFailedLogins mocks base method.
+func (m *MockUserRepository) ResetFailedLogins(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetFailedLogins", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetFailedLogins indicates an expected call of ResetFailedLogins.
+func (mr *MockUserRepositoryMockRecorder) ResetFailedLogins(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetFailedLogins", reflect.TypeOf((*MockUserRepository)(nil).ResetFailedLogins), arg0)
+}
+
 // Update mocks base method.
 func (m *MockUserRepository) Update(arg0 string, arg1 entities.User) (entities.User, error) {
 	m.ctrl.T.Helper()
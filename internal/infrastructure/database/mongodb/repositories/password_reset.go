@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+	domain "github.com/waliqueiroz/letmeask-api/internal/domain/errors"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/database/mongodb/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type PasswordResetRepository struct {
+	passwordResetCollection *mongo.Collection
+}
+
+func NewPasswordResetRepository(db *mongo.Database) *PasswordResetRepository {
+	return &PasswordResetRepository{
+		passwordResetCollection: db.Collection("password_resets"),
+	}
+}
+
+func (repository *PasswordResetRepository) Create(reset entities.PasswordReset) (entities.PasswordReset, error) {
+	userID, err := primitive.ObjectIDFromHex(reset.UserID)
+	if err != nil {
+		return entities.PasswordReset{}, err
+	}
+
+	newReset := models.PasswordReset{
+		ID:        primitive.NewObjectID(),
+		UserID:    userID,
+		TokenHash: reset.TokenHash,
+		ExpiresAt: reset.ExpiresAt,
+		CreatedAt: time.Now(),
+	}
+
+	_, err = repository.passwordResetCollection.InsertOne(context.Background(), newReset)
+	if err != nil {
+		return entities.PasswordReset{}, err
+	}
+
+	return newReset.ToDomain(), nil
+}
+
+func (repository *PasswordResetRepository) FindByTokenHash(tokenHash string) (entities.PasswordReset, error) {
+	filter := bson.M{"token_hash": tokenHash}
+
+	result := repository.passwordResetCollection.FindOne(context.Background(), filter)
+
+	var reset models.PasswordReset
+
+	if err := result.Decode(&reset); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return entities.PasswordReset{}, domain.NewResourceNotFoundError("redefinição de senha não encontrada")
+		}
+		return entities.PasswordReset{}, err
+	}
+
+	return reset.ToDomain(), nil
+}
+
+func (repository *PasswordResetRepository) MarkAsUsed(resetID string) error {
+	id, err := primitive.ObjectIDFromHex(resetID)
+	if err != nil {
+		return err
+	}
+
+	// The used_at condition makes the token single use even when two
+	// requests try to redeem it at the same time.
+	filter := bson.M{"_id": id, "used_at": nil}
+
+	update := bson.M{
+		"$set": bson.M{"used_at": time.Now()},
+	}
+
+	result, err := repository.passwordResetCollection.UpdateOne(context.Background(), filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return domain.NewConflictError("o token de redefinição já foi utilizado")
+	}
+
+	return nil
+}
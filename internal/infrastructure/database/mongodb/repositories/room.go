@@ -3,14 +3,18 @@ package repositories
 import (
 	"context"
 	"errors"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
 	domain "github.com/waliqueiroz/letmeask-api/internal/domain/errors"
+	"github.com/waliqueiroz/letmeask-api/internal/domain/repositories"
 	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/database/mongodb/models"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type RoomRepository struct {
@@ -29,6 +33,11 @@ func (repository *RoomRepository) Create(room entities.Room) (entities.Room, err
 		return entities.Room{}, err
 	}
 
+	members, err := repository.entityMembersToModelMembers(room.Members)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
 	newRoom := models.Room{
 		Title: room.Title,
 		Author: models.Author{
@@ -36,13 +45,33 @@ func (repository *RoomRepository) Create(room entities.Room) (entities.Room, err
 			Name:   room.Author.Name,
 			Avatar: room.Author.Avatar,
 		},
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		Members:          members,
+		Settings:         repository.entitySettingsToModelSettings(room.Settings),
+		ScheduledStartAt: room.ScheduledStartAt,
+		ScheduledEndAt:   room.ScheduledEndAt,
+		Version:          1,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
 	}
 
-	result, err := repository.roomCollection.InsertOne(context.Background(), newRoom)
-	if err != nil {
-		return entities.Room{}, err
+	var result *mongo.InsertOneResult
+
+	// The unique index on the code is what catches a collision, in which case
+	// a new code is drawn and the insert is tried again.
+	for attempt := 1; ; attempt++ {
+		newRoom.Code, err = generateRoomCode()
+		if err != nil {
+			return entities.Room{}, err
+		}
+
+		result, err = repository.roomCollection.InsertOne(context.Background(), newRoom)
+		if err == nil {
+			break
+		}
+
+		if !mongo.IsDuplicateKeyError(err) || attempt == roomCodeAttempts {
+			return entities.Room{}, err
+		}
 	}
 
 	objectID := result.InsertedID.(primitive.ObjectID)
@@ -50,8 +79,211 @@ func (repository *RoomRepository) Create(room entities.Room) (entities.Room, err
 	return repository.FindByID(objectID.Hex())
 }
 
+func (repository *RoomRepository) FindAll(filter repositories.RoomFilter) (repositories.RoomPage, error) {
+	ctx := context.Background()
+
+	query := bson.M{}
+
+	if filter.AuthorID != "" {
+		authorID, err := primitive.ObjectIDFromHex(filter.AuthorID)
+		if err != nil {
+			return repositories.RoomPage{}, err
+		}
+
+		query["author._id"] = authorID
+	}
+
+	// A room past its scheduled end is already over, even if the scheduler
+	// did not get to set its ended_at yet.
+	now := time.Now()
+
+	switch filter.Status {
+	case repositories.RoomStatusOpen:
+		query["ended_at"] = nil
+		query["$or"] = []bson.M{
+			{"scheduled_end_at": nil},
+			{"scheduled_end_at": bson.M{"$gt": now}},
+		}
+	case repositories.RoomStatusEnded:
+		query["$or"] = []bson.M{
+			{"ended_at": bson.M{"$ne": nil}},
+			{"scheduled_end_at": bson.M{"$lte": now}},
+		}
+	}
+
+	if filter.Search != "" {
+		query["$text"] = bson.M{"$search": filter.Search}
+	}
+
+	createdAt := bson.M{}
+	if filter.CreatedFrom != nil {
+		createdAt["$gte"] = *filter.CreatedFrom
+	}
+	if filter.CreatedTo != nil {
+		createdAt["$lte"] = *filter.CreatedTo
+	}
+	if len(createdAt) > 0 {
+		query["created_at"] = createdAt
+	}
+
+	visible := []bson.M{
+		{"settings.visibility": bson.M{"$in": bson.A{nil, entities.RoomVisibilityPublic}}},
+	}
+
+	if filter.ViewerID != "" {
+		viewerID, err := primitive.ObjectIDFromHex(filter.ViewerID)
+		if err != nil {
+			return repositories.RoomPage{}, err
+		}
+
+		visible = append(visible, bson.M{"author._id": viewerID}, bson.M{"members.user._id": viewerID})
+	}
+
+	query = bson.M{"$and": []bson.M{query, {"$or": visible}}}
+
+	sortField := filter.SortBy
+	if sortField == "" {
+		sortField = "created_at"
+	}
+
+	if filter.Cursor != "" {
+		position, err := decodeCursor(filter.Cursor)
+		if err != nil {
+			return repositories.RoomPage{}, domain.NewBadRequestError("cursor inválido")
+		}
+
+		query = bson.M{"$and": []bson.M{query, afterCursor(sortField, filter.Descending, filter.Descending, position)}}
+	}
+
+	// One extra document is fetched only to find out whether there is a next page.
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: sortField, Value: direction(filter.Descending)}, {Key: "_id", Value: direction(filter.Descending)}}).
+		SetLimit(int64(filter.Limit + 1)).
+		SetProjection(bson.M{"questions": 0})
+
+	result, err := repository.roomCollection.Find(ctx, query, findOptions)
+	if err != nil {
+		return repositories.RoomPage{}, err
+	}
+
+	defer result.Close(ctx)
+
+	var rooms []models.Room
+
+	for result.Next(ctx) {
+		var room models.Room
+
+		err := result.Decode(&room)
+		if err != nil {
+			return repositories.RoomPage{}, err
+		}
+
+		rooms = append(rooms, room)
+	}
+
+	if err := result.Err(); err != nil {
+		return repositories.RoomPage{}, err
+	}
+
+	page := repositories.RoomPage{
+		Rooms: []entities.Room{},
+	}
+
+	if len(rooms) > filter.Limit {
+		rooms = rooms[:filter.Limit]
+		last := rooms[len(rooms)-1]
+
+		var value interface{} = last.CreatedAt
+		if sortField == "title" {
+			value = last.Title
+		}
+
+		page.NextCursor, err = encodeCursor(value, last.ID)
+		if err != nil {
+			return repositories.RoomPage{}, err
+		}
+	}
+
+	for _, room := range rooms {
+		page.Rooms = append(page.Rooms, room.ToDomain())
+	}
+
+	return page, nil
+}
+
 func (repository *RoomRepository) FindByID(roomID string) (entities.Room, error) {
+	id, err := primitive.ObjectIDFromHex(roomID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	return repository.findOne(bson.M{"_id": id})
+}
+
+// FindByCode ignores the case of the code, since people type what they hear.
+func (repository *RoomRepository) FindByCode(code string) (entities.Room, error) {
+	return repository.findOne(bson.M{"code": strings.ToUpper(code)})
+}
+
+// FindDueRooms finds the rooms whose scheduled end has passed but were not
+// ended yet.
+func (repository *RoomRepository) FindDueRooms(now time.Time) ([]entities.Room, error) {
+	ctx := context.Background()
+
+	filter := bson.M{
+		"ended_at":         nil,
+		"scheduled_end_at": bson.M{"$lte": now},
+	}
+
+	result, err := repository.roomCollection.Find(ctx, filter, options.Find().SetProjection(bson.M{"questions": 0}))
+	if err != nil {
+		return []entities.Room{}, err
+	}
+
+	defer result.Close(ctx)
+
+	rooms := []entities.Room{}
+
+	for result.Next(ctx) {
+		var room models.Room
+
+		err := result.Decode(&room)
+		if err != nil {
+			return []entities.Room{}, err
+		}
+
+		rooms = append(rooms, room.ToDomain())
+	}
+
+	if err := result.Err(); err != nil {
+		return []entities.Room{}, err
+	}
+
+	return rooms, nil
+}
+
+func (repository *RoomRepository) findOne(filter bson.M) (entities.Room, error) {
+	result := repository.roomCollection.FindOne(context.Background(), filter)
+
+	var room models.Room
+
+	if err := result.Decode(&room); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return entities.Room{}, domain.NewResourceNotFoundError("sala não encontrada")
+		}
+		return entities.Room{}, err
+	}
+
+	sort.SliceStable(room.Questions, func(i, j int) bool {
+		return room.Questions[i].CreatedAt.After(room.Questions[j].CreatedAt)
+	})
+
+	return room.ToDomain(), nil
+}
+
+func (repository *RoomRepository) FindSummaryByID(roomID string) (entities.Room, error) {
 	ctx := context.Background()
+
 	id, err := primitive.ObjectIDFromHex(roomID)
 	if err != nil {
 		return entities.Room{}, err
@@ -59,29 +291,14 @@ func (repository *RoomRepository) FindByID(roomID string) (entities.Room, error)
 
 	pipeline := []bson.M{
 		{"$match": bson.M{"_id": id}},
-		{"$unwind": bson.M{"path": "$questions", "preserveNullAndEmptyArrays": true}},
-		{"$sort": bson.M{"questions.created_at": -1}},
-		{"$group": bson.M{
-			"_id":        "$_id",
-			"title":      bson.M{"$first": "$title"},
-			"author":     bson.M{"$first": "$author"},
-			"created_at": bson.M{"$first": "$created_at"},
-			"updated_at": bson.M{"$first": "$updated_at"},
-			"ended_at":   bson.M{"$first": "$ended_at"},
-			"questions":  bson.M{"$push": "$questions"},
-		}},
 		{"$addFields": bson.M{
-			"questions": bson.M{
-				"$filter": bson.M{
-					"input": "$questions",
-					"as":    "question",
-					"cond": bson.M{"$and": []interface{}{
-						bson.M{"$ne": []interface{}{"$$question", nil}},
-						bson.M{"$ne": []interface{}{"$$question.content", ""}},
-					}},
-				},
-			},
+			"question_count": bson.M{"$size": bson.M{"$filter": bson.M{
+				"input": bson.M{"$ifNull": []interface{}{"$questions", bson.A{}}},
+				"as":    "question",
+				"cond":  bson.M{"$eq": []interface{}{bson.M{"$ifNull": []interface{}{"$$question.deletion", nil}}, nil}},
+			}}},
 		}},
+		{"$project": bson.M{"questions": 0}},
 	}
 
 	result, err := repository.roomCollection.Aggregate(ctx, pipeline)
@@ -89,6 +306,8 @@ func (repository *RoomRepository) FindByID(roomID string) (entities.Room, error)
 		return entities.Room{}, err
 	}
 
+	defer result.Close(ctx)
+
 	if result.Next(ctx) {
 		var room models.Room
 
@@ -100,126 +319,939 @@ func (repository *RoomRepository) FindByID(roomID string) (entities.Room, error)
 		return room.ToDomain(), nil
 	}
 
+	if err := result.Err(); err != nil {
+		return entities.Room{}, err
+	}
+
 	return entities.Room{}, domain.NewResourceNotFoundError("sala não encontrada")
 }
 
-func (repository *RoomRepository) Update(roomID string, room entities.Room) (entities.Room, error) {
+func (repository *RoomRepository) FindQuestions(roomID string, filter repositories.QuestionFilter) (repositories.QuestionPage, error) {
+	ctx := context.Background()
+
 	id, err := primitive.ObjectIDFromHex(roomID)
 	if err != nil {
-		return entities.Room{}, err
+		return repositories.QuestionPage{}, err
 	}
 
-	filter := bson.M{"_id": id}
+	order, ok := questionOrders[filter.Order]
+	if !ok {
+		order = questionOrders[repositories.QuestionOrderNewest]
+	}
 
-	questions, err := repository.entityQuestionsToModelQuestions(room.Questions)
-	if err != nil {
-		return entities.Room{}, err
+	match := bson.M{}
+
+	// Questions without a status were asked before moderation existed and
+	// count as approved.
+	switch {
+	case filter.Status == entities.QuestionStatusApproved:
+		match["status"] = bson.M{"$nin": []string{entities.QuestionStatusPending, entities.QuestionStatusRejected}}
+	case filter.Status != "":
+		match["status"] = filter.Status
 	}
 
-	fields := bson.M{
-		"title":      room.Title,
-		"questions":  questions,
-		"updated_at": time.Now(),
+	if filter.Deleted {
+		match["deletion"] = bson.M{"$ne": nil}
+	} else {
+		match["deletion"] = nil
 	}
 
-	if room.EndedAt != nil {
-		fields["ended_at"] = room.EndedAt
+	if filter.IsAnswered != nil {
+		match["is_answered"] = *filter.IsAnswered
 	}
 
-	update := bson.M{
-		"$set": fields,
+	if filter.IsHighlighted != nil {
+		match["is_highlighted"] = *filter.IsHighlighted
 	}
 
-	_, err = repository.roomCollection.UpdateOne(context.Background(), filter, update)
+	if filter.Cursor != "" {
+		position, err := decodeCursor(filter.Cursor)
+		if err != nil {
+			return repositories.QuestionPage{}, domain.NewBadRequestError("cursor inválido")
+		}
+
+		match = bson.M{"$and": []bson.M{match, afterCursor(order.field, order.descending, order.idDescending, position)}}
+	}
+
+	pipeline := []bson.M{
+		{"$match": bson.M{"_id": id}},
+		{"$unwind": "$questions"},
+		{"$replaceRoot": bson.M{"newRoot": "$questions"}},
+		{"$addFields": bson.M{
+			"like_count": bson.M{"$size": bson.M{"$ifNull": []interface{}{"$likes", bson.A{}}}},
+		}},
+		{"$match": match},
+		{"$sort": bson.D{
+			{Key: order.field, Value: direction(order.descending)},
+			{Key: "_id", Value: direction(order.idDescending)},
+		}},
+		// One extra question is fetched only to find out whether there is a next page.
+		{"$limit": filter.Limit + 1},
+	}
 
+	result, err := repository.roomCollection.Aggregate(ctx, pipeline)
 	if err != nil {
-		if errors.Is(err, mongo.ErrNoDocuments) {
-			return entities.Room{}, domain.NewResourceNotFoundError("sala não encontrada")
-		}
-		return entities.Room{}, err
+		return repositories.QuestionPage{}, err
 	}
 
-	return repository.FindByID(roomID)
-}
+	defer result.Close(ctx)
 
-func (repository *RoomRepository) entityQuestionsToModelQuestions(entityQuestions []entities.Question) ([]models.Question, error) {
-	var questions []models.Question
+	var questions []rankedQuestion
 
-	for _, entityQuestion := range entityQuestions {
-		var questionID primitive.ObjectID
-		var err error
+	for result.Next(ctx) {
+		var question rankedQuestion
 
-		if entityQuestion.ID == "" {
-			questionID = primitive.NewObjectID()
-		} else {
-			questionID, err = primitive.ObjectIDFromHex(entityQuestion.ID)
-			if err != nil {
-				return []models.Question{}, err
-			}
+		err := result.Decode(&question)
+		if err != nil {
+			return repositories.QuestionPage{}, err
 		}
 
-		authorID, err := primitive.ObjectIDFromHex(entityQuestion.Author.ID)
+		questions = append(questions, question)
+	}
+
+	if err := result.Err(); err != nil {
+		return repositories.QuestionPage{}, err
+	}
+
+	if len(questions) == 0 {
+		count, err := repository.roomCollection.CountDocuments(ctx, bson.M{"_id": id})
 		if err != nil {
-			return []models.Question{}, err
+			return repositories.QuestionPage{}, err
 		}
 
-		likes, err := repository.entityLikesToModelLikes(entityQuestion.Likes)
-		if err != nil {
-			return []models.Question{}, err
+		if count == 0 {
+			return repositories.QuestionPage{}, domain.NewResourceNotFoundError("sala não encontrada")
 		}
+	}
 
-		question := models.Question{
-			ID:            questionID,
-			Content:       entityQuestion.Content,
-			IsHighlighted: entityQuestion.IsHighlighted,
-			IsAnswered:    entityQuestion.IsAnswered,
-			Author: models.Author{
-				ID:     authorID,
-				Name:   entityQuestion.Author.Name,
-				Avatar: entityQuestion.Author.Avatar,
-			},
-			Likes:     likes,
-			CreatedAt: entityQuestion.CreatedAt,
+	page := repositories.QuestionPage{
+		Questions: []entities.Question{},
+	}
+
+	if len(questions) > filter.Limit {
+		questions = questions[:filter.Limit]
+		last := questions[len(questions)-1]
+
+		page.NextCursor, err = encodeCursor(last.sortValue(order.field), last.ID)
+		if err != nil {
+			return repositories.QuestionPage{}, err
 		}
+	}
 
-		questions = append(questions, question)
+	for _, question := range questions {
+		page.Questions = append(page.Questions, question.ToDomain())
 	}
 
-	return questions, nil
+	return page, nil
 }
 
-func (repository *RoomRepository) entityLikesToModelLikes(entityLikes []entities.Like) ([]models.Like, error) {
-	var likes []models.Like
-	for _, entityLike := range entityLikes {
-		var likeID primitive.ObjectID
-		var err error
+type questionOrder struct {
+	field        string
+	descending   bool
+	idDescending bool
+}
 
-		if entityLike.ID == "" {
-			likeID = primitive.NewObjectID()
-		} else {
-			likeID, err = primitive.ObjectIDFromHex(entityLike.ID)
-			if err != nil {
-				return []models.Like{}, err
-			}
-		}
+// questionOrders maps each ordering mode to its sort key. Ties are broken by
+// _id, which follows creation time, so equal questions show newest first
+// (oldest first when ordering by age ascending).
+var questionOrders = map[string]questionOrder{
+	repositories.QuestionOrderNewest:           {field: "created_at", descending: true, idDescending: true},
+	repositories.QuestionOrderOldest:           {field: "created_at", descending: false, idDescending: false},
+	repositories.QuestionOrderMostLiked:        {field: "like_count", descending: true, idDescending: true},
+	repositories.QuestionOrderHighlightedFirst: {field: "is_highlighted", descending: true, idDescending: true},
+	repositories.QuestionOrderUnansweredFirst:  {field: "is_answered", descending: false, idDescending: true},
+}
 
-		authorID, err := primitive.ObjectIDFromHex(entityLike.Author.ID)
-		if err != nil {
-			return []models.Like{}, err
-		}
+// rankedQuestion is a question unwound from its room, along with the
+// computed fields it can be sorted by.
+type rankedQuestion struct {
+	models.Question `bson:",inline"`
+	LikeCount       int `bson:"like_count"`
+}
 
-		like := models.Like{
-			ID: likeID,
-			Author: models.Author{
-				ID:     authorID,
-				Name:   entityLike.Author.Name,
-				Avatar: entityLike.Author.Avatar,
-			},
-			CreatedAt: entityLike.CreatedAt,
-		}
+func (question rankedQuestion) sortValue(field string) interface{} {
+	switch field {
+	case "like_count":
+		return question.LikeCount
+	case "is_highlighted":
+		return question.IsHighlighted
+	case "is_answered":
+		return question.IsAnswered
+	default:
+		return question.CreatedAt
+	}
+}
 
-		likes = append(likes, like)
+func (repository *RoomRepository) Update(roomID string, room entities.Room) (entities.Room, error) {
+	ctx := context.Background()
+
+	id, err := primitive.ObjectIDFromHex(roomID)
+	if err != nil {
+		return entities.Room{}, err
 	}
 
-	return likes, nil
+	// The write only goes through if nobody else changed the room since it
+	// was read.
+	filter := repository.versionFilter(id, room.Version)
+
+	update, err := repository.roomUpdate(room)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	result, err := repository.roomCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if result.MatchedCount == 0 {
+		return entities.Room{}, repository.unmatched(ctx, id, domain.NewConflictError("a sala foi alterada por outra requisição"))
+	}
+
+	return repository.FindByID(roomID)
+}
+
+// roomUpdate turns the whole room into the update that replaces it. Empty
+// lists are written as empty arrays rather than null, or pushing to them
+// later would fail.
+func (repository *RoomRepository) roomUpdate(room entities.Room) (bson.M, error) {
+	questions, err := repository.entityQuestionsToModelQuestions(room.Questions)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := repository.entityMembersToModelMembers(room.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := bson.M{
+		"title":      room.Title,
+		"questions":  questions,
+		"members":    members,
+		"settings":   repository.entitySettingsToModelSettings(room.Settings),
+		"updated_at": time.Now(),
+	}
+
+	// A reopened room gets its end cleared, so what is missing from the room
+	// has to be removed from the document as well.
+	unset := bson.M{}
+
+	if room.EndedAt != nil {
+		fields["ended_at"] = room.EndedAt
+	} else {
+		unset["ended_at"] = ""
+	}
+
+	if room.ScheduledEndAt != nil {
+		fields["scheduled_end_at"] = room.ScheduledEndAt
+	} else {
+		unset["scheduled_end_at"] = ""
+	}
+
+	return bson.M{
+		"$set":   fields,
+		"$unset": unset,
+		"$inc":   bson.M{"version": 1},
+	}, nil
+}
+
+// PushQuestion adds the question to the room. A limited question is only
+// added while its author is still within the question limits of the room,
+// so concurrent questions cannot all get past them.
+func (repository *RoomRepository) PushQuestion(roomID string, question entities.Question, limited bool) (entities.Question, error) {
+	ctx := context.Background()
+
+	id, err := primitive.ObjectIDFromHex(roomID)
+	if err != nil {
+		return entities.Question{}, err
+	}
+
+	newQuestion, err := repository.entityQuestionToModelQuestion(question)
+	if err != nil {
+		return entities.Question{}, err
+	}
+
+	filter := bson.M{"_id": id}
+	if limited {
+		filter["$expr"] = repository.withinQuestionLimits(newQuestion.Author.ID, newQuestion.CreatedAt)
+	}
+
+	update := bson.M{
+		"$push": bson.M{"questions": newQuestion},
+		"$set":  bson.M{"updated_at": time.Now()},
+		"$inc":  bson.M{"version": 1},
+	}
+
+	result, err := repository.roomCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return entities.Question{}, err
+	}
+
+	if result.MatchedCount == 0 {
+		return entities.Question{}, repository.unmatched(ctx, id, domain.NewConflictError("o limite de perguntas da sala foi atingido."))
+	}
+
+	return newQuestion.ToDomain(), nil
+}
+
+// withinQuestionLimits is the same check as Room.CheckQuestionLimits, done by
+// the database on the room as it is at the time of the write.
+func (repository *RoomRepository) withinQuestionLimits(authorID primitive.ObjectID, now time.Time) bson.M {
+	maxQuestions := bson.M{"$ifNull": bson.A{"$settings.max_questions_per_user", 0}}
+	minSeconds := bson.M{"$ifNull": bson.A{"$settings.min_seconds_between_questions", 0}}
+	maxOpen := bson.M{"$ifNull": bson.A{"$settings.max_open_questions_per_user", 0}}
+
+	open := bson.M{"$filter": bson.M{
+		"input": "$$asked",
+		"as":    "question",
+		"cond": bson.M{"$and": bson.A{
+			bson.M{"$eq": bson.A{bson.M{"$ifNull": bson.A{"$$question.deletion", nil}}, nil}},
+			bson.M{"$ne": bson.A{"$$question.is_answered", true}},
+			bson.M{"$ne": bson.A{"$$question.status", entities.QuestionStatusRejected}},
+		}},
+	}}
+
+	return bson.M{"$let": bson.M{
+		"vars": bson.M{
+			"asked": bson.M{"$filter": bson.M{
+				"input": bson.M{"$ifNull": bson.A{"$questions", bson.A{}}},
+				"as":    "question",
+				"cond":  bson.M{"$eq": bson.A{"$$question.author._id", authorID}},
+			}},
+		},
+		"in": bson.M{"$and": bson.A{
+			bson.M{"$or": bson.A{
+				bson.M{"$lte": bson.A{maxQuestions, 0}},
+				bson.M{"$lt": bson.A{bson.M{"$size": "$$asked"}, maxQuestions}},
+			}},
+			bson.M{"$or": bson.A{
+				bson.M{"$lte": bson.A{minSeconds, 0}},
+				bson.M{"$eq": bson.A{bson.M{"$size": "$$asked"}, 0}},
+				bson.M{"$lte": bson.A{
+					bson.M{"$add": bson.A{bson.M{"$max": "$$asked.created_at"}, bson.M{"$multiply": bson.A{minSeconds, 1000}}}},
+					now,
+				}},
+			}},
+			bson.M{"$or": bson.A{
+				bson.M{"$lte": bson.A{maxOpen, 0}},
+				bson.M{"$lt": bson.A{bson.M{"$size": open}, maxOpen}},
+			}},
+		}},
+	}}
+}
+
+func (repository *RoomRepository) AddLike(roomID string, questionID string, like entities.Like) (entities.Room, error) {
+	id, err := primitive.ObjectIDFromHex(roomID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	qID, err := primitive.ObjectIDFromHex(questionID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	newLike, err := repository.entityLikeToModelLike(like)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	// The $ne on the like authors makes the push a no-op when the author has
+	// already liked the question, even under concurrent requests.
+	filter := bson.M{
+		"_id": id,
+		"questions": bson.M{"$elemMatch": bson.M{
+			"_id":              qID,
+			"likes.author._id": bson.M{"$ne": newLike.Author.ID},
+		}},
+	}
+
+	update := bson.M{
+		"$push": bson.M{"questions.$.likes": newLike},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+
+	return repository.updateOne(roomID, filter, update, domain.NewConflictError("você já curtiu esta pergunta."))
+}
+
+func (repository *RoomRepository) RemoveLike(roomID string, questionID string, likeID string) (entities.Room, error) {
+	id, err := primitive.ObjectIDFromHex(roomID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	qID, err := primitive.ObjectIDFromHex(questionID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	lID, err := primitive.ObjectIDFromHex(likeID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	filter := bson.M{
+		"_id": id,
+		"questions": bson.M{"$elemMatch": bson.M{
+			"_id":       qID,
+			"likes._id": lID,
+		}},
+	}
+
+	update := bson.M{
+		"$pull": bson.M{"questions.$.likes": bson.M{"_id": lID}},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+
+	return repository.updateOne(roomID, filter, update, domain.NewResourceNotFoundError("curtida não encontrada."))
+}
+
+func (repository *RoomRepository) AddReply(roomID string, questionID string, reply entities.Reply) (entities.Room, error) {
+	id, err := primitive.ObjectIDFromHex(roomID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	qID, err := primitive.ObjectIDFromHex(questionID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	newReply, err := repository.entityReplyToModelReply(reply)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	filter := bson.M{"_id": id, "questions._id": qID}
+
+	update := bson.M{
+		"$push": bson.M{"questions.$.replies": newReply},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+
+	return repository.updateOne(roomID, filter, update, domain.NewResourceNotFoundError("pergunta não encontrada."))
+}
+
+func (repository *RoomRepository) UpdateReply(roomID string, questionID string, reply entities.Reply) (entities.Room, error) {
+	id, err := primitive.ObjectIDFromHex(roomID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	qID, err := primitive.ObjectIDFromHex(questionID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	rID, err := primitive.ObjectIDFromHex(reply.ID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	filter := bson.M{"_id": id}
+
+	update := bson.M{
+		"$set": bson.M{
+			"questions.$[question].replies.$[reply].content":     reply.Content,
+			"questions.$[question].replies.$[reply].is_official": reply.IsOfficial,
+			"questions.$[question].replies.$[reply].updated_at":  reply.UpdatedAt,
+			"updated_at": time.Now(),
+		},
+	}
+
+	updateOptions := options.Update().SetArrayFilters(options.ArrayFilters{
+		Filters: []interface{}{
+			bson.M{"question._id": qID},
+			bson.M{"reply._id": rID},
+		},
+	})
+
+	return repository.updateOne(roomID, filter, update, domain.NewResourceNotFoundError("resposta não encontrada."), updateOptions)
+}
+
+// RemoveReply pulls the reply from the question, unlinking it in the same
+// write when it was the answer of the question.
+func (repository *RoomRepository) RemoveReply(roomID string, questionID string, replyID string) (entities.Room, error) {
+	id, err := primitive.ObjectIDFromHex(roomID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	qID, err := primitive.ObjectIDFromHex(questionID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	rID, err := primitive.ObjectIDFromHex(replyID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	filter := bson.M{"_id": id}
+
+	update := bson.M{
+		"$pull":  bson.M{"questions.$[question].replies": bson.M{"_id": rID}},
+		"$unset": bson.M{"questions.$[answered].answer_id": ""},
+		"$set":   bson.M{"updated_at": time.Now()},
+	}
+
+	updateOptions := options.Update().SetArrayFilters(options.ArrayFilters{
+		Filters: []interface{}{
+			bson.M{"question._id": qID},
+			bson.M{"answered._id": qID, "answered.answer_id": rID},
+		},
+	})
+
+	return repository.updateOne(roomID, filter, update, domain.NewResourceNotFoundError("resposta não encontrada."), updateOptions)
+}
+
+func (repository *RoomRepository) SetQuestionHighlight(roomID string, questionID string, isHighlighted bool) (entities.Room, error) {
+	return repository.setQuestionFields(roomID, questionID, bson.M{
+		"questions.$.is_highlighted": isHighlighted,
+	})
+}
+
+func (repository *RoomRepository) SetQuestionStatus(roomID string, questionID string, status string) (entities.Room, error) {
+	return repository.setQuestionFields(roomID, questionID, bson.M{
+		"questions.$.status": status,
+	})
+}
+
+func (repository *RoomRepository) MarkQuestionAsDeleted(roomID string, questionID string, deletion entities.QuestionDeletion) (entities.Room, error) {
+	newDeletion, err := repository.entityDeletionToModelDeletion(&deletion)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	return repository.setQuestionFields(roomID, questionID, bson.M{
+		"questions.$.deletion": newDeletion,
+	})
+}
+
+func (repository *RoomRepository) MarkQuestionAsAnswered(roomID string, questionID string, answerID string) (entities.Room, error) {
+	fields := bson.M{
+		"questions.$.is_answered": true,
+	}
+
+	if answerID != "" {
+		aID, err := primitive.ObjectIDFromHex(answerID)
+		if err != nil {
+			return entities.Room{}, err
+		}
+
+		fields["questions.$.answer_id"] = aID
+	}
+
+	return repository.setQuestionFields(roomID, questionID, fields)
+}
+
+func (repository *RoomRepository) setQuestionFields(roomID string, questionID string, fields bson.M) (entities.Room, error) {
+	id, err := primitive.ObjectIDFromHex(roomID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	qID, err := primitive.ObjectIDFromHex(questionID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	filter := bson.M{"_id": id, "questions._id": qID}
+
+	fields["updated_at"] = time.Now()
+
+	update := bson.M{
+		"$set": fields,
+	}
+
+	return repository.updateOne(roomID, filter, update, domain.NewResourceNotFoundError("pergunta não encontrada."))
+}
+
+func (repository *RoomRepository) PushPoll(roomID string, poll entities.Poll) (entities.Room, error) {
+	id, err := primitive.ObjectIDFromHex(roomID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	newPoll := models.Poll{
+		ID:             primitive.NewObjectID(),
+		Question:       poll.Question,
+		MultipleChoice: poll.MultipleChoice,
+		Status:         poll.Status,
+		CreatedAt:      poll.CreatedAt,
+	}
+
+	for _, option := range poll.Options {
+		newPoll.Options = append(newPoll.Options, models.PollOption{
+			ID:   primitive.NewObjectID(),
+			Text: option.Text,
+		})
+	}
+
+	filter := bson.M{"_id": id}
+
+	update := bson.M{
+		"$push": bson.M{"polls": newPoll},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+
+	return repository.updateOne(roomID, filter, update, domain.NewResourceNotFoundError("sala não encontrada"))
+}
+
+func (repository *RoomRepository) SetPollStatus(roomID string, pollID string, status string) (entities.Room, error) {
+	id, err := primitive.ObjectIDFromHex(roomID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	pID, err := primitive.ObjectIDFromHex(pollID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	filter := bson.M{"_id": id, "polls._id": pID}
+
+	fields := bson.M{
+		"polls.$.status": status,
+		"updated_at":     time.Now(),
+	}
+
+	update := bson.M{"$set": fields}
+
+	if status == entities.PollStatusClosed {
+		fields["polls.$.closed_at"] = time.Now()
+	} else {
+		update["$unset"] = bson.M{"polls.$.closed_at": ""}
+	}
+
+	return repository.updateOne(roomID, filter, update, domain.NewResourceNotFoundError("enquete não encontrada."))
+}
+
+func (repository *RoomRepository) AddVote(roomID string, pollID string, vote entities.PollVote) (entities.Room, error) {
+	id, err := primitive.ObjectIDFromHex(roomID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	pID, err := primitive.ObjectIDFromHex(pollID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	userID, err := primitive.ObjectIDFromHex(vote.UserID)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	newVote := models.PollVote{
+		UserID:    userID,
+		CreatedAt: vote.CreatedAt,
+	}
+
+	for _, optionID := range vote.OptionIDs {
+		oID, err := primitive.ObjectIDFromHex(optionID)
+		if err != nil {
+			return entities.Room{}, err
+		}
+
+		newVote.OptionIDs = append(newVote.OptionIDs, oID)
+	}
+
+	// Just like likes, the $ne on the voters makes the push a no-op when the
+	// user has already voted, even under concurrent requests, and the status
+	// keeps late votes out of a poll that was just closed.
+	filter := bson.M{
+		"_id": id,
+		"polls": bson.M{"$elemMatch": bson.M{
+			"_id":           pID,
+			"status":        entities.PollStatusOpen,
+			"votes.user_id": bson.M{"$ne": userID},
+		}},
+	}
+
+	update := bson.M{
+		"$push": bson.M{"polls.$.votes": newVote},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+
+	return repository.updateOne(roomID, filter, update, domain.NewConflictError("você já votou nesta enquete ou ela não está mais aberta."))
+}
+
+// updateOne applies a targeted update to the room. When the filter matches
+// nothing, either the room is gone or what the update is about is not in the
+// state the filter asks for, which is reported as reason.
+func (repository *RoomRepository) updateOne(roomID string, filter bson.M, update bson.M, reason error, opts ...*options.UpdateOptions) (entities.Room, error) {
+	ctx := context.Background()
+
+	update["$inc"] = bson.M{"version": 1}
+
+	result, err := repository.roomCollection.UpdateOne(ctx, filter, update, opts...)
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	if result.MatchedCount == 0 {
+		id, err := primitive.ObjectIDFromHex(roomID)
+		if err != nil {
+			return entities.Room{}, err
+		}
+
+		return entities.Room{}, repository.unmatched(ctx, id, reason)
+	}
+
+	return repository.FindByID(roomID)
+}
+
+func (repository *RoomRepository) versionFilter(id primitive.ObjectID, version int64) bson.M {
+	filter := bson.M{"_id": id, "version": version}
+	if version == 0 {
+		filter["version"] = bson.M{"$in": []interface{}{0, nil}}
+	}
+
+	return filter
+}
+
+// unmatched tells a room that is gone from one that is there but did not
+// match the rest of the filter of a write, which is reported as reason.
+func (repository *RoomRepository) unmatched(ctx context.Context, id primitive.ObjectID, reason error) error {
+	count, err := repository.roomCollection.CountDocuments(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return domain.NewResourceNotFoundError("sala não encontrada")
+	}
+
+	return reason
+}
+
+func (repository *RoomRepository) entitySettingsToModelSettings(entitySettings entities.RoomSettings) models.RoomSettings {
+	return models.RoomSettings{
+		AllowAnonymousQuestions:    entitySettings.AllowAnonymousQuestions,
+		RequireApproval:            entitySettings.RequireApproval,
+		Visibility:                 entitySettings.Visibility,
+		Passcode:                   entitySettings.Passcode,
+		InvitedUserIDs:             entitySettings.InvitedUserIDs,
+		InvitedDomains:             entitySettings.InvitedDomains,
+		MaxQuestionsPerUser:        entitySettings.MaxQuestionsPerUser,
+		MinSecondsBetweenQuestions: entitySettings.MinSecondsBetweenQuestions,
+		MaxOpenQuestionsPerUser:    entitySettings.MaxOpenQuestionsPerUser,
+	}
+}
+
+func (repository *RoomRepository) entityMembersToModelMembers(entityMembers []entities.Member) ([]models.Member, error) {
+	members := []models.Member{}
+
+	for _, entityMember := range entityMembers {
+		userID, err := primitive.ObjectIDFromHex(entityMember.User.ID)
+		if err != nil {
+			return []models.Member{}, err
+		}
+
+		members = append(members, models.Member{
+			User: models.Author{
+				ID:     userID,
+				Name:   entityMember.User.Name,
+				Avatar: entityMember.User.Avatar,
+			},
+			Role:    entityMember.Role,
+			AddedAt: entityMember.AddedAt,
+		})
+	}
+
+	return members, nil
+}
+
+func (repository *RoomRepository) entityQuestionsToModelQuestions(entityQuestions []entities.Question) ([]models.Question, error) {
+	questions := []models.Question{}
+
+	for _, entityQuestion := range entityQuestions {
+		question, err := repository.entityQuestionToModelQuestion(entityQuestion)
+		if err != nil {
+			return []models.Question{}, err
+		}
+
+		questions = append(questions, question)
+	}
+
+	return questions, nil
+}
+
+func (repository *RoomRepository) entityQuestionToModelQuestion(entityQuestion entities.Question) (models.Question, error) {
+	var questionID primitive.ObjectID
+	var err error
+
+	if entityQuestion.ID == "" {
+		questionID = primitive.NewObjectID()
+	} else {
+		questionID, err = primitive.ObjectIDFromHex(entityQuestion.ID)
+		if err != nil {
+			return models.Question{}, err
+		}
+	}
+
+	authorID, err := primitive.ObjectIDFromHex(entityQuestion.Author.ID)
+	if err != nil {
+		return models.Question{}, err
+	}
+
+	likes, err := repository.entityLikesToModelLikes(entityQuestion.Likes)
+	if err != nil {
+		return models.Question{}, err
+	}
+
+	replies, err := repository.entityRepliesToModelReplies(entityQuestion.Replies)
+	if err != nil {
+		return models.Question{}, err
+	}
+
+	var revisions []models.QuestionRevision
+	for _, revision := range entityQuestion.Revisions {
+		revisions = append(revisions, models.QuestionRevision{
+			Content:   revision.Content,
+			CreatedAt: revision.CreatedAt,
+		})
+	}
+
+	deletion, err := repository.entityDeletionToModelDeletion(entityQuestion.Deletion)
+	if err != nil {
+		return models.Question{}, err
+	}
+
+	var answerID primitive.ObjectID
+	if entityQuestion.AnswerID != "" {
+		answerID, err = primitive.ObjectIDFromHex(entityQuestion.AnswerID)
+		if err != nil {
+			return models.Question{}, err
+		}
+	}
+
+	return models.Question{
+		ID:            questionID,
+		Content:       entityQuestion.Content,
+		IsHighlighted: entityQuestion.IsHighlighted,
+		IsAnswered:    entityQuestion.IsAnswered,
+		IsAnonymous:   entityQuestion.IsAnonymous,
+		Status:        entityQuestion.Status,
+		Author: models.Author{
+			ID:     authorID,
+			Name:   entityQuestion.Author.Name,
+			Avatar: entityQuestion.Author.Avatar,
+		},
+		Likes:     likes,
+		Replies:   replies,
+		AnswerID:  answerID,
+		Revisions: revisions,
+		CreatedAt: entityQuestion.CreatedAt,
+		EditedAt:  entityQuestion.EditedAt,
+		Deletion:  deletion,
+	}, nil
+}
+
+func (repository *RoomRepository) entityLikesToModelLikes(entityLikes []entities.Like) ([]models.Like, error) {
+	var likes []models.Like
+	for _, entityLike := range entityLikes {
+		like, err := repository.entityLikeToModelLike(entityLike)
+		if err != nil {
+			return []models.Like{}, err
+		}
+
+		likes = append(likes, like)
+	}
+
+	return likes, nil
+}
+
+func (repository *RoomRepository) entityLikeToModelLike(entityLike entities.Like) (models.Like, error) {
+	var likeID primitive.ObjectID
+	var err error
+
+	if entityLike.ID == "" {
+		likeID = primitive.NewObjectID()
+	} else {
+		likeID, err = primitive.ObjectIDFromHex(entityLike.ID)
+		if err != nil {
+			return models.Like{}, err
+		}
+	}
+
+	authorID, err := primitive.ObjectIDFromHex(entityLike.Author.ID)
+	if err != nil {
+		return models.Like{}, err
+	}
+
+	return models.Like{
+		ID: likeID,
+		Author: models.Author{
+			ID:     authorID,
+			Name:   entityLike.Author.Name,
+			Avatar: entityLike.Author.Avatar,
+		},
+		CreatedAt: entityLike.CreatedAt,
+	}, nil
+}
+
+func (repository *RoomRepository) entityRepliesToModelReplies(entityReplies []entities.Reply) ([]models.Reply, error) {
+	var replies []models.Reply
+	for _, entityReply := range entityReplies {
+		reply, err := repository.entityReplyToModelReply(entityReply)
+		if err != nil {
+			return []models.Reply{}, err
+		}
+
+		replies = append(replies, reply)
+	}
+
+	return replies, nil
+}
+
+func (repository *RoomRepository) entityReplyToModelReply(entityReply entities.Reply) (models.Reply, error) {
+	var replyID primitive.ObjectID
+	var err error
+
+	if entityReply.ID == "" {
+		replyID = primitive.NewObjectID()
+	} else {
+		replyID, err = primitive.ObjectIDFromHex(entityReply.ID)
+		if err != nil {
+			return models.Reply{}, err
+		}
+	}
+
+	authorID, err := primitive.ObjectIDFromHex(entityReply.Author.ID)
+	if err != nil {
+		return models.Reply{}, err
+	}
+
+	return models.Reply{
+		ID:      replyID,
+		Content: entityReply.Content,
+		Author: models.Author{
+			ID:     authorID,
+			Name:   entityReply.Author.Name,
+			Avatar: entityReply.Author.Avatar,
+		},
+		IsOfficial: entityReply.IsOfficial,
+		CreatedAt:  entityReply.CreatedAt,
+		UpdatedAt:  entityReply.UpdatedAt,
+	}, nil
+}
+
+func (repository *RoomRepository) entityDeletionToModelDeletion(entityDeletion *entities.QuestionDeletion) (*models.QuestionDeletion, error) {
+	if entityDeletion == nil {
+		return nil, nil
+	}
+
+	deletedBy, err := primitive.ObjectIDFromHex(entityDeletion.DeletedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.QuestionDeletion{
+		Reason:    entityDeletion.Reason,
+		DeletedBy: deletedBy,
+		DeletedAt: entityDeletion.DeletedAt,
+	}, nil
 }
@@ -0,0 +1,30 @@
+package repositories
+
+import (
+	"crypto/rand"
+	"math/big"
+)
+
+// roomCodeAlphabet leaves out the characters that are easily mistaken for one
+// another when read aloud or on a projector, such as 0 and O or 1, I and L.
+const roomCodeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+
+const roomCodeLength = 6
+
+const roomCodeAttempts = 5
+
+func generateRoomCode() (string, error) {
+	max := big.NewInt(int64(len(roomCodeAlphabet)))
+	code := make([]byte, roomCodeLength)
+
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+
+		code[i] = roomCodeAlphabet[n.Int64()]
+	}
+
+	return string(code), nil
+}
@@ -0,0 +1,74 @@
+package repositories_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/database/mongodb/repositories"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+var _ = Describe("Room", func() {
+	Describe("Building the update of a whole room", func() {
+		var room entities.Room
+		var set bson.Raw
+
+		JustBeforeEach(func() {
+			update, err := repositories.RoomUpdate(room)
+			Expect(err).NotTo(HaveOccurred())
+
+			document, err := bson.Marshal(update)
+			Expect(err).NotTo(HaveOccurred())
+
+			set = bson.Raw(document).Lookup("$set").Document()
+		})
+
+		When("the room has no questions and no members yet", func() {
+			BeforeEach(func() {
+				room = entities.Room{
+					ID:     "621f5ec1e07fdbb81c8221f7",
+					Title:  "Dúvidas sobre Symbian",
+					Author: entities.Author{ID: "621f5e02e07fdbb81c8221f5"},
+					Settings: entities.RoomSettings{
+						AllowAnonymousQuestions: true,
+					},
+				}
+			})
+
+			It("should write the questions as an empty array, so questions can still be pushed", func() {
+				questions := set.Lookup("questions")
+				Expect(questions.Type).To(Equal(bsontype.Array))
+
+				values, err := questions.Array().Values()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(BeEmpty())
+			})
+
+			It("should write the members as an empty array", func() {
+				Expect(set.Lookup("members").Type).To(Equal(bsontype.Array))
+			})
+		})
+
+		When("the room has questions", func() {
+			BeforeEach(func() {
+				room = entities.Room{
+					ID:     "621f5ec1e07fdbb81c8221f7",
+					Title:  "Dúvidas sobre Symbian",
+					Author: entities.Author{ID: "621f5e02e07fdbb81c8221f5"},
+					Questions: []entities.Question{{
+						ID:      "621f5f94e07fdbb81c8221f9",
+						Content: "O Nokia N95 foi o melhor celular com o Symbian?",
+						Author:  entities.Author{ID: "621f5f40e07fdbb81c8221f8"},
+					}},
+				}
+			})
+
+			It("should write every question", func() {
+				values, err := set.Lookup("questions").Array().Values()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(HaveLen(1))
+			})
+		})
+	})
+})
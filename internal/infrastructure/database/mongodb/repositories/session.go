@@ -0,0 +1,118 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+	domain "github.com/waliqueiroz/letmeask-api/internal/domain/errors"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/database/mongodb/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type SessionRepository struct {
+	sessionCollection *mongo.Collection
+}
+
+func NewSessionRepository(db *mongo.Database) *SessionRepository {
+	return &SessionRepository{
+		sessionCollection: db.Collection("sessions"),
+	}
+}
+
+func (repository *SessionRepository) Create(session entities.Session) (entities.Session, error) {
+	userID, err := primitive.ObjectIDFromHex(session.UserID)
+	if err != nil {
+		return entities.Session{}, err
+	}
+
+	// A login starts a new family; every refresh token rotated from it keeps
+	// the same family so the whole chain can be revoked at once.
+	familyID := primitive.NewObjectID()
+	if session.FamilyID != "" {
+		familyID, err = primitive.ObjectIDFromHex(session.FamilyID)
+		if err != nil {
+			return entities.Session{}, err
+		}
+	}
+
+	newSession := models.Session{
+		ID:              primitive.NewObjectID(),
+		UserID:          userID,
+		FamilyID:        familyID,
+		TokenHash:       session.TokenHash,
+		TokenGeneration: session.TokenGeneration,
+		ExpiresAt:       session.ExpiresAt,
+		CreatedAt:       time.Now(),
+	}
+
+	_, err = repository.sessionCollection.InsertOne(context.Background(), newSession)
+	if err != nil {
+		return entities.Session{}, err
+	}
+
+	return newSession.ToDomain(), nil
+}
+
+func (repository *SessionRepository) FindByTokenHash(tokenHash string) (entities.Session, error) {
+	filter := bson.M{"token_hash": tokenHash}
+
+	result := repository.sessionCollection.FindOne(context.Background(), filter)
+
+	var session models.Session
+
+	if err := result.Decode(&session); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return entities.Session{}, domain.NewResourceNotFoundError("sessão não encontrada")
+		}
+		return entities.Session{}, err
+	}
+
+	return session.ToDomain(), nil
+}
+
+func (repository *SessionRepository) MarkAsRotated(sessionID string) error {
+	id, err := primitive.ObjectIDFromHex(sessionID)
+	if err != nil {
+		return err
+	}
+
+	// Only an active session can be rotated, so two requests racing with the
+	// same refresh token can never both succeed.
+	filter := bson.M{"_id": id, "rotated_at": nil, "revoked_at": nil}
+
+	update := bson.M{
+		"$set": bson.M{"rotated_at": time.Now()},
+	}
+
+	result, err := repository.sessionCollection.UpdateOne(context.Background(), filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return domain.NewConflictError("a sessão já foi renovada")
+	}
+
+	return nil
+}
+
+func (repository *SessionRepository) RevokeFamily(familyID string) error {
+	id, err := primitive.ObjectIDFromHex(familyID)
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{"family_id": id, "revoked_at": nil}
+
+	update := bson.M{
+		"$set": bson.M{"revoked_at": time.Now()},
+	}
+
+	_, err = repository.sessionCollection.UpdateMany(context.Background(), filter, update)
+
+	return err
+}
@@ -11,6 +11,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type UserRepository struct {
@@ -55,6 +56,7 @@ func (repository *UserRepository) Create(user entities.User) (entities.User, err
 		Avatar:    user.Avatar,
 		Email:     user.Email,
 		Password:  user.Password,
+		Role:      user.Role,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
@@ -116,13 +118,19 @@ func (repository *UserRepository) Update(userID string, user entities.User) (ent
 
 	filter := bson.M{"_id": id}
 
-	update := bson.M{
-		"$set": bson.M{
-			"name":       user.Name,
-			"email":      user.Email,
-			"avatar":     user.Avatar,
-			"updated_at": time.Now(),
-		},
+	set := bson.M{
+		"name":       user.Name,
+		"email":      user.Email,
+		"avatar":     user.Avatar,
+		"updated_at": time.Now(),
+	}
+
+	update := bson.M{"$set": set}
+
+	if user.VerifiedAt != nil {
+		set["verified_at"] = *user.VerifiedAt
+	} else {
+		update["$unset"] = bson.M{"verified_at": ""}
 	}
 
 	_, err = repository.userCollection.UpdateOne(context.Background(), filter, update)
@@ -145,11 +153,21 @@ func (repository *UserRepository) UpdatePassword(userID string, password string)
 
 	filter := bson.M{"_id": id}
 
+	// Choosing a new password also lifts a lockout caused by failed logins,
+	// since it is how the owner of the account gets back in.
 	update := bson.M{
 		"$set": bson.M{
 			"password":   password,
 			"updated_at": time.Now(),
 		},
+		"$inc": bson.M{
+			"token_generation": 1,
+		},
+		"$unset": bson.M{
+			"failed_logins":       "",
+			"failed_logins_since": "",
+			"locked_until":        "",
+		},
 	}
 
 	_, err = repository.userCollection.UpdateOne(context.Background(), filter, update)
@@ -164,6 +182,30 @@ func (repository *UserRepository) UpdatePassword(userID string, password string)
 	return nil
 }
 
+func (repository *UserRepository) MarkAsVerified(userID string) (entities.User, error) {
+	id, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return entities.User{}, err
+	}
+
+	// Verifying twice keeps the moment of the first verification.
+	filter := bson.M{"_id": id, "verified_at": nil}
+
+	update := bson.M{
+		"$set": bson.M{
+			"verified_at": time.Now(),
+			"updated_at":  time.Now(),
+		},
+	}
+
+	_, err = repository.userCollection.UpdateOne(context.Background(), filter, update)
+	if err != nil {
+		return entities.User{}, err
+	}
+
+	return repository.FindByID(userID)
+}
+
 func (repository *UserRepository) FindByEmail(email string) (entities.User, error) {
 	filter := bson.M{"email": email}
 
@@ -180,3 +222,81 @@ func (repository *UserRepository) FindByEmail(email string) (entities.User, erro
 
 	return user.ToDomain(), nil
 }
+
+// IncrementFailedLogins counts one more failed login in a single update, so
+// attempts made in parallel are all counted. Failures from before windowStart
+// are forgotten and the count starts over at this one.
+func (repository *UserRepository) IncrementFailedLogins(userID string, windowStart time.Time, now time.Time) (int, error) {
+	id, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	filter := bson.M{"_id": id}
+
+	expired := bson.M{"$lt": bson.A{bson.M{"$ifNull": bson.A{"$failed_logins_since", time.Time{}}}, windowStart}}
+
+	update := bson.A{
+		bson.M{"$set": bson.M{
+			"failed_logins": bson.M{"$cond": bson.A{
+				expired,
+				1,
+				bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$failed_logins", 0}}, 1}},
+			}},
+			"failed_logins_since": bson.M{"$cond": bson.A{expired, now, "$failed_logins_since"}},
+		}},
+	}
+
+	result := repository.userCollection.FindOneAndUpdate(context.Background(), filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
+
+	var user models.User
+
+	if err := result.Decode(&user); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, domain.NewResourceNotFoundError("usuário não encontrado")
+		}
+		return 0, err
+	}
+
+	return user.FailedLogins, nil
+}
+
+func (repository *UserRepository) LockUntil(userID string, lockedUntil time.Time) error {
+	id, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{"_id": id}
+
+	update := bson.M{
+		"$set": bson.M{
+			"locked_until": lockedUntil,
+		},
+	}
+
+	_, err = repository.userCollection.UpdateOne(context.Background(), filter, update)
+
+	return err
+}
+
+func (repository *UserRepository) ResetFailedLogins(userID string) error {
+	id, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{"_id": id}
+
+	update := bson.M{
+		"$unset": bson.M{
+			"failed_logins":       "",
+			"failed_logins_since": "",
+			"locked_until":        "",
+		},
+	}
+
+	_, err = repository.userCollection.UpdateOne(context.Background(), filter, update)
+
+	return err
+}
@@ -8,13 +8,15 @@ import (
 )
 
 type AuthController struct {
-	authService services.AuthService
-	validator   providers.Validator
+	authService   services.AuthService
+	authenticator providers.Authenticator
+	validator     providers.Validator
 }
 
-func NewAuthController(authService services.AuthService, validationProvider providers.Validator) *AuthController {
+func NewAuthController(authService services.AuthService, authProvider providers.Authenticator, validationProvider providers.Validator) *AuthController {
 	return &AuthController{
 		authService,
+		authProvider,
 		validationProvider,
 	}
 }
@@ -39,3 +41,49 @@ func (controller *AuthController) Login(ctx *fiber.Ctx) error {
 
 	return ctx.JSON(response)
 }
+
+func (controller *AuthController) Refresh(ctx *fiber.Ctx) error {
+	var refreshToken dtos.RefreshTokenDTO
+
+	err := ctx.BodyParser(&refreshToken)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	errors := controller.validator.ValidateStruct(refreshToken)
+	if errors != nil {
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errors)
+	}
+
+	response, err := controller.authService.Refresh(refreshToken.RefreshToken)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(response)
+}
+
+func (controller *AuthController) Logout(ctx *fiber.Ctx) error {
+	claims, err := controller.authenticator.ExtractClaims(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	var refreshToken dtos.RefreshTokenDTO
+
+	err = ctx.BodyParser(&refreshToken)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	errors := controller.validator.ValidateStruct(refreshToken)
+	if errors != nil {
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errors)
+	}
+
+	if err := controller.authService.Logout(claims, refreshToken.RefreshToken); err != nil {
+		return err
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
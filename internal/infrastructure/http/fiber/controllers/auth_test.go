@@ -13,7 +13,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/waliqueiroz/letmeask-api/internal/application/dtos"
+	application "github.com/waliqueiroz/letmeask-api/internal/application/errors"
+	"github.com/waliqueiroz/letmeask-api/internal/application/providers"
 	"github.com/waliqueiroz/letmeask-api/internal/application/services/mocks"
+	authMocks "github.com/waliqueiroz/letmeask-api/internal/infrastructure/authentication/mocks"
 	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/http/fiber/controllers"
 	infrastructure "github.com/waliqueiroz/letmeask-api/internal/infrastructure/http/fiber/errors"
 	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/http/fiber/routes"
@@ -35,7 +38,7 @@ var _ = Describe("Auth", func() {
 				ErrorHandler: infrastructure.Handler,
 			})
 
-			routes.SetupAuthRoutes(app, authController)
+			routes.SetupAuthRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, authController)
 
 			req := httptest.NewRequest(fiber.MethodPost, routes.LOGIN_ROUTE, input)
 			req.Header.Set("Content-Type", "application/json")
@@ -70,9 +73,11 @@ var _ = Describe("Auth", func() {
 				mockAuthService := mocks.NewMockAuthService(mockCtrl)
 				mockAuthService.EXPECT().Login(credentialsDTO).Return(expectedLoginResult, nil).Times(1)
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				authController = controllers.NewAuthController(mockAuthService, validationProvider)
+				authController = controllers.NewAuthController(mockAuthService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 200 OK", func() {
@@ -112,9 +117,11 @@ var _ = Describe("Auth", func() {
 				mockAuthService := mocks.NewMockAuthService(mockCtrl)
 				mockAuthService.EXPECT().Login(credentialsDTO).Return(dtos.AuthDTO{}, errors.New("an error")).Times(1)
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				authController = controllers.NewAuthController(mockAuthService, validationProvider)
+				authController = controllers.NewAuthController(mockAuthService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 500 Internal Server Error", func() {
@@ -139,9 +146,172 @@ var _ = Describe("Auth", func() {
 
 				mockAuthService := mocks.NewMockAuthService(mockCtrl)
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				authController = controllers.NewAuthController(mockAuthService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 422 Unprocessable Entity", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnprocessableEntity))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("request body comes with an invalid payload", func() {
+			BeforeEach(func() {
+				// Entrada
+				input = bytes.NewBuffer(nil)
+
+				// Mocks
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthService := mocks.NewMockAuthService(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				authController = controllers.NewAuthController(mockAuthService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 400 Bad Request", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusBadRequest))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Refreshing tokens", func() {
+		var input *bytes.Buffer
+		var response *http.Response
+		var authController *controllers.AuthController
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupAuthRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, authController)
+
+			req := httptest.NewRequest(fiber.MethodPost, routes.REFRESH_ROUTE, input)
+			req.Header.Set("Content-Type", "application/json")
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the refresh token is rotated with success", func() {
+			var expectedRefreshResult dtos.AuthDTO
+
+			BeforeEach(func() {
+				refreshTokenSerialized, err := ioutil.ReadFile("../../../../../test/resources/refresh_token_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				authSerialized, err := ioutil.ReadFile("../../../../../test/resources/auth.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				// Entrada
+				input = bytes.NewBuffer(refreshTokenSerialized)
+
+				// Mocks
+				err = json.Unmarshal(authSerialized, &expectedRefreshResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				var refreshTokenDTO dtos.RefreshTokenDTO
+				err = json.Unmarshal(refreshTokenSerialized, &refreshTokenDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthService := mocks.NewMockAuthService(mockCtrl)
+				mockAuthService.EXPECT().Refresh(refreshTokenDTO.RefreshToken).Return(expectedRefreshResult, nil).Times(1)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				authController = controllers.NewAuthController(mockAuthService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
+			})
+
+			It("response body should be equal to authService.Refresh result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var auth dtos.AuthDTO
+				err = json.Unmarshal(body, &auth)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(auth).To(Equal(expectedRefreshResult))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the refresh token is not valid", func() {
+			BeforeEach(func() {
+				refreshTokenSerialized, err := ioutil.ReadFile("../../../../../test/resources/refresh_token_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				// Entrada
+				input = bytes.NewBuffer(refreshTokenSerialized)
+
+				// Mocks
+				var refreshTokenDTO dtos.RefreshTokenDTO
+				err = json.Unmarshal(refreshTokenSerialized, &refreshTokenDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthService := mocks.NewMockAuthService(mockCtrl)
+				mockAuthService.EXPECT().Refresh(refreshTokenDTO.RefreshToken).Return(dtos.AuthDTO{}, application.NewUnauthorizedError("refresh token inválido")).Times(1)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				authController = controllers.NewAuthController(mockAuthService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnauthorized))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the refresh token is missing", func() {
+			BeforeEach(func() {
+				// Entrada
+				input = bytes.NewBufferString("{}")
+
+				// Mocks
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthService := mocks.NewMockAuthService(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				authController = controllers.NewAuthController(mockAuthService, validationProvider)
+				authController = controllers.NewAuthController(mockAuthService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 422 Unprocessable Entity", func() {
@@ -163,9 +333,193 @@ var _ = Describe("Auth", func() {
 
 				mockAuthService := mocks.NewMockAuthService(mockCtrl)
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				authController = controllers.NewAuthController(mockAuthService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 400 Bad Request", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusBadRequest))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Performing logout", func() {
+		claims := providers.TokenClaims{
+			ID:     "5f1d7a3c9b2e4f60a1b2c3d4e5f60718",
+			UserID: "6117e377b6e7bae09f52c483",
+		}
+
+		var input *bytes.Buffer
+		var response *http.Response
+		var authController *controllers.AuthController
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupAuthRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, authController)
+
+			req := httptest.NewRequest(fiber.MethodPost, routes.LOGOUT_ROUTE, input)
+			req.Header.Set("Content-Type", "application/json")
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("logout is performed with success", func() {
+			BeforeEach(func() {
+				refreshTokenSerialized, err := ioutil.ReadFile("../../../../../test/resources/refresh_token_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				// Entrada
+				input = bytes.NewBuffer(refreshTokenSerialized)
+
+				// Mocks
+				var refreshTokenDTO dtos.RefreshTokenDTO
+				err = json.Unmarshal(refreshTokenSerialized, &refreshTokenDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthService := mocks.NewMockAuthService(mockCtrl)
+				mockAuthService.EXPECT().Logout(claims, refreshTokenDTO.RefreshToken).Return(nil).Times(1)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractClaims(gomock.Any()).Return(claims, nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				authController = controllers.NewAuthController(mockAuthService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 204 No Content", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusNoContent))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the refresh token is not valid", func() {
+			BeforeEach(func() {
+				refreshTokenSerialized, err := ioutil.ReadFile("../../../../../test/resources/refresh_token_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				// Entrada
+				input = bytes.NewBuffer(refreshTokenSerialized)
+
+				// Mocks
+				var refreshTokenDTO dtos.RefreshTokenDTO
+				err = json.Unmarshal(refreshTokenSerialized, &refreshTokenDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthService := mocks.NewMockAuthService(mockCtrl)
+				mockAuthService.EXPECT().Logout(claims, refreshTokenDTO.RefreshToken).Return(application.NewUnauthorizedError("refresh token inválido")).Times(1)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractClaims(gomock.Any()).Return(claims, nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				authController = controllers.NewAuthController(mockAuthService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnauthorized))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the access token is not valid", func() {
+			BeforeEach(func() {
+				refreshTokenSerialized, err := ioutil.ReadFile("../../../../../test/resources/refresh_token_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				// Entrada
+				input = bytes.NewBuffer(refreshTokenSerialized)
+
+				// Mocks
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthService := mocks.NewMockAuthService(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractClaims(gomock.Any()).Return(providers.TokenClaims{}, application.NewUnauthorizedError("token inválido")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				authController = controllers.NewAuthController(mockAuthService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnauthorized))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the refresh token is missing", func() {
+			BeforeEach(func() {
+				// Entrada
+				input = bytes.NewBufferString("{}")
+
+				// Mocks
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthService := mocks.NewMockAuthService(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractClaims(gomock.Any()).Return(claims, nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				authController = controllers.NewAuthController(mockAuthService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 422 Unprocessable Entity", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnprocessableEntity))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("request body comes with an invalid payload", func() {
+			BeforeEach(func() {
+				// Entrada
+				input = bytes.NewBuffer(nil)
+
+				// Mocks
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthService := mocks.NewMockAuthService(mockCtrl)
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractClaims(gomock.Any()).Return(claims, nil).Times(1)
+
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				authController = controllers.NewAuthController(mockAuthService, validationProvider)
+				authController = controllers.NewAuthController(mockAuthService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 400 Bad Request", func() {
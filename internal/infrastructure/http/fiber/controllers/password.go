@@ -0,0 +1,60 @@
+package controllers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/waliqueiroz/letmeask-api/internal/application/dtos"
+	"github.com/waliqueiroz/letmeask-api/internal/application/providers"
+	"github.com/waliqueiroz/letmeask-api/internal/application/services"
+)
+
+type PasswordController struct {
+	passwordService services.PasswordService
+	validator       providers.Validator
+}
+
+func NewPasswordController(passwordService services.PasswordService, validationProvider providers.Validator) *PasswordController {
+	return &PasswordController{
+		passwordService,
+		validationProvider,
+	}
+}
+
+func (controller *PasswordController) Forgot(ctx *fiber.Ctx) error {
+	var forgotPassword dtos.ForgotPasswordDTO
+
+	err := ctx.BodyParser(&forgotPassword)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	errors := controller.validator.ValidateStruct(forgotPassword)
+	if errors != nil {
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errors)
+	}
+
+	if err := controller.passwordService.ForgotPassword(forgotPassword); err != nil {
+		return err
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (controller *PasswordController) Reset(ctx *fiber.Ctx) error {
+	var resetPassword dtos.ResetPasswordDTO
+
+	err := ctx.BodyParser(&resetPassword)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	errors := controller.validator.ValidateStruct(resetPassword)
+	if errors != nil {
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errors)
+	}
+
+	if err := controller.passwordService.ResetPassword(resetPassword); err != nil {
+		return err
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,302 @@
+package controllers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/waliqueiroz/letmeask-api/internal/application/dtos"
+	"github.com/waliqueiroz/letmeask-api/internal/application/services/mocks"
+	domain "github.com/waliqueiroz/letmeask-api/internal/domain/errors"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/http/fiber/controllers"
+	infrastructure "github.com/waliqueiroz/letmeask-api/internal/infrastructure/http/fiber/errors"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/http/fiber/routes"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/validation/goplayground"
+)
+
+var _ = Describe("Password", func() {
+
+	Describe("Requesting a password reset", func() {
+		var input *bytes.Buffer
+		var response *http.Response
+		var passwordController *controllers.PasswordController
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupPasswordRoutes(app, passwordController)
+
+			req := httptest.NewRequest(fiber.MethodPost, routes.FORGOT_PASSWORD_ROUTE, input)
+			req.Header.Set("Content-Type", "application/json")
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the reset is requested with success", func() {
+			BeforeEach(func() {
+				forgotPasswordSerialized, err := ioutil.ReadFile("../../../../../test/resources/forgot_password_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				// Entrada
+				input = bytes.NewBuffer(forgotPasswordSerialized)
+
+				// Mocks
+				var forgotPasswordDTO dtos.ForgotPasswordDTO
+				err = json.Unmarshal(forgotPasswordSerialized, &forgotPasswordDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockPasswordService := mocks.NewMockPasswordService(mockCtrl)
+				mockPasswordService.EXPECT().ForgotPassword(forgotPasswordDTO).Return(nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				passwordController = controllers.NewPasswordController(mockPasswordService, validationProvider)
+			})
+
+			It("response status code should be 204 No Content", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusNoContent))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("a general error occurs", func() {
+			BeforeEach(func() {
+				forgotPasswordSerialized, err := ioutil.ReadFile("../../../../../test/resources/forgot_password_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				// Entrada
+				input = bytes.NewBuffer(forgotPasswordSerialized)
+
+				// Mocks
+				var forgotPasswordDTO dtos.ForgotPasswordDTO
+				err = json.Unmarshal(forgotPasswordSerialized, &forgotPasswordDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockPasswordService := mocks.NewMockPasswordService(mockCtrl)
+				mockPasswordService.EXPECT().ForgotPassword(forgotPasswordDTO).Return(errors.New("an error")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				passwordController = controllers.NewPasswordController(mockPasswordService, validationProvider)
+			})
+
+			It("response status code should be 500 Internal Server Error", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusInternalServerError))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the email is not valid", func() {
+			BeforeEach(func() {
+				forgotPasswordSerialized, err := ioutil.ReadFile("../../../../../test/resources/forgot_password_request_invalid.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				// Entrada
+				input = bytes.NewBuffer(forgotPasswordSerialized)
+
+				// Mocks
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockPasswordService := mocks.NewMockPasswordService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				passwordController = controllers.NewPasswordController(mockPasswordService, validationProvider)
+			})
+
+			It("response status code should be 422 Unprocessable Entity", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnprocessableEntity))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("request body comes with an invalid payload", func() {
+			BeforeEach(func() {
+				// Entrada
+				input = bytes.NewBuffer(nil)
+
+				// Mocks
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockPasswordService := mocks.NewMockPasswordService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				passwordController = controllers.NewPasswordController(mockPasswordService, validationProvider)
+			})
+
+			It("response status code should be 400 Bad Request", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusBadRequest))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Resetting the password", func() {
+		var input *bytes.Buffer
+		var response *http.Response
+		var passwordController *controllers.PasswordController
+		var mockCtrl *gomock.Controller
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupPasswordRoutes(app, passwordController)
+
+			req := httptest.NewRequest(fiber.MethodPost, routes.RESET_PASSWORD_ROUTE, input)
+			req.Header.Set("Content-Type", "application/json")
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the password is reset with success", func() {
+			BeforeEach(func() {
+				resetPasswordSerialized, err := ioutil.ReadFile("../../../../../test/resources/reset_password_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				// Entrada
+				input = bytes.NewBuffer(resetPasswordSerialized)
+
+				// Mocks
+				var resetPasswordDTO dtos.ResetPasswordDTO
+				err = json.Unmarshal(resetPasswordSerialized, &resetPasswordDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockPasswordService := mocks.NewMockPasswordService(mockCtrl)
+				mockPasswordService.EXPECT().ResetPassword(resetPasswordDTO).Return(nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				passwordController = controllers.NewPasswordController(mockPasswordService, validationProvider)
+			})
+
+			It("response status code should be 204 No Content", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusNoContent))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the reset token is not valid", func() {
+			BeforeEach(func() {
+				resetPasswordSerialized, err := ioutil.ReadFile("../../../../../test/resources/reset_password_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				// Entrada
+				input = bytes.NewBuffer(resetPasswordSerialized)
+
+				// Mocks
+				var resetPasswordDTO dtos.ResetPasswordDTO
+				err = json.Unmarshal(resetPasswordSerialized, &resetPasswordDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockPasswordService := mocks.NewMockPasswordService(mockCtrl)
+				mockPasswordService.EXPECT().ResetPassword(resetPasswordDTO).Return(domain.NewBadRequestError("token de redefinição inválido ou expirado")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				passwordController = controllers.NewPasswordController(mockPasswordService, validationProvider)
+			})
+
+			It("response status code should be 400 Bad Request", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusBadRequest))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the new password is missing", func() {
+			BeforeEach(func() {
+				resetPasswordSerialized, err := ioutil.ReadFile("../../../../../test/resources/reset_password_request_incomplete.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				// Entrada
+				input = bytes.NewBuffer(resetPasswordSerialized)
+
+				// Mocks
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockPasswordService := mocks.NewMockPasswordService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				passwordController = controllers.NewPasswordController(mockPasswordService, validationProvider)
+			})
+
+			It("response status code should be 422 Unprocessable Entity", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnprocessableEntity))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("request body comes with an invalid payload", func() {
+			BeforeEach(func() {
+				// Entrada
+				input = bytes.NewBuffer(nil)
+
+				// Mocks
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockPasswordService := mocks.NewMockPasswordService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				passwordController = controllers.NewPasswordController(mockPasswordService, validationProvider)
+			})
+
+			It("response status code should be 400 Bad Request", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusBadRequest))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+})
@@ -11,31 +11,38 @@ import (
 type RoomController struct {
 	roomService   services.RoomService
 	authenticator providers.Authenticator
+	broadcaster   providers.Broadcaster
 	validator     providers.Validator
 }
 
-func NewRoomController(roomService services.RoomService, authProvider providers.Authenticator, validationProvider providers.Validator) *RoomController {
+func NewRoomController(roomService services.RoomService, authProvider providers.Authenticator, broadcaster providers.Broadcaster, validationProvider providers.Validator) *RoomController {
 	return &RoomController{
 		roomService,
 		authProvider,
+		broadcaster,
 		validationProvider,
 	}
 }
 
 func (controller *RoomController) Create(ctx *fiber.Ctx) error {
-	var room entities.Room
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	var roomDTO dtos.RoomDTO
 
-	err := ctx.BodyParser(&room)
+	err = ctx.BodyParser(&roomDTO)
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
-	errors := controller.validator.ValidateStruct(room)
+	errors := controller.validator.ValidateStruct(roomDTO)
 	if errors != nil {
 		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errors)
 	}
 
-	room, err = controller.roomService.Create(room)
+	room, err := controller.roomService.Create(userID, roomDTO)
 	if err != nil {
 		return err
 	}
@@ -59,10 +66,83 @@ func (controller *RoomController) EndRoom(ctx *fiber.Ctx) error {
 	return ctx.JSON(room)
 }
 
+func (controller *RoomController) ReopenRoom(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomID")
+
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	room, err := controller.roomService.ReopenRoom(userID, roomID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(room)
+}
+
+func (controller *RoomController) Index(ctx *fiber.Ctx) error {
+	var query dtos.RoomQueryDTO
+
+	err := ctx.QueryParser(&query)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return controller.findRooms(ctx, query)
+}
+
+func (controller *RoomController) FindByAuthor(ctx *fiber.Ctx) error {
+	var query dtos.RoomQueryDTO
+
+	err := ctx.QueryParser(&query)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	query.AuthorID = ctx.Params("userID")
+
+	return controller.findRooms(ctx, query)
+}
+
+func (controller *RoomController) findRooms(ctx *fiber.Ctx, query dtos.RoomQueryDTO) error {
+	errors := controller.validator.ValidateStruct(query)
+	if errors != nil {
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errors)
+	}
+
+	viewerID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	page, err := controller.roomService.FindAll(viewerID, query)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(page)
+}
+
 func (controller *RoomController) FindByID(ctx *fiber.Ctx) error {
 	roomID := ctx.Params("roomID")
 
-	room, err := controller.roomService.FindByID(roomID)
+	viewerID, err := controller.viewerID(ctx)
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	grant := controller.roomGrant(ctx)
+
+	var room entities.Room
+
+	if ctx.Query("summary") == "true" {
+		room, err = controller.roomService.FindSummaryByID(viewerID, roomID, grant)
+	} else {
+		room, err = controller.roomService.FindByID(viewerID, roomID, grant)
+	}
+
 	if err != nil {
 		return err
 	}
@@ -70,12 +150,140 @@ func (controller *RoomController) FindByID(ctx *fiber.Ctx) error {
 	return ctx.JSON(room)
 }
 
+func (controller *RoomController) FindByCode(ctx *fiber.Ctx) error {
+	code := ctx.Params("code")
+
+	viewerID, err := controller.viewerID(ctx)
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	room, err := controller.roomService.FindByCode(viewerID, code, controller.roomGrant(ctx))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(room)
+}
+
+func (controller *RoomController) JoinRoom(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomID")
+
+	viewerID, err := controller.viewerID(ctx)
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	var joinDTO dtos.JoinRoomDTO
+
+	err = ctx.BodyParser(&joinDTO)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	grant, err := controller.roomService.JoinRoom(viewerID, roomID, joinDTO)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(grant)
+}
+
+func (controller *RoomController) FindQuestions(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomID")
+
+	viewerID, err := controller.viewerID(ctx)
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	var query dtos.QuestionQueryDTO
+
+	err = ctx.QueryParser(&query)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	errors := controller.validator.ValidateStruct(query)
+	if errors != nil {
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errors)
+	}
+
+	page, err := controller.roomService.FindQuestions(viewerID, roomID, query, controller.roomGrant(ctx))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(page)
+}
+
+func (controller *RoomController) FindPendingQuestions(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomID")
+
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	var query dtos.QuestionQueryDTO
+
+	err = ctx.QueryParser(&query)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	errors := controller.validator.ValidateStruct(query)
+	if errors != nil {
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errors)
+	}
+
+	page, err := controller.roomService.FindPendingQuestions(userID, roomID, query)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(page)
+}
+
+func (controller *RoomController) FindDeletedQuestions(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomID")
+
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	var query dtos.QuestionQueryDTO
+
+	err = ctx.QueryParser(&query)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	errors := controller.validator.ValidateStruct(query)
+	if errors != nil {
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errors)
+	}
+
+	page, err := controller.roomService.FindDeletedQuestions(userID, roomID, query)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(page)
+}
+
 func (controller *RoomController) CreateQuestion(ctx *fiber.Ctx) error {
 	roomID := ctx.Params("roomID")
 
-	var question entities.Question
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	var question dtos.QuestionDTO
 
-	err := ctx.BodyParser(&question)
+	err = ctx.BodyParser(&question)
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
@@ -85,7 +293,7 @@ func (controller *RoomController) CreateQuestion(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errors)
 	}
 
-	room, err := controller.roomService.CreateQuestion(roomID, question)
+	room, err := controller.roomService.CreateQuestion(userID, roomID, question, controller.roomGrant(ctx))
 	if err != nil {
 		return err
 	}
@@ -122,23 +330,62 @@ func (controller *RoomController) UpdateQuestion(ctx *fiber.Ctx) error {
 	return ctx.JSON(room)
 }
 
-func (controller *RoomController) LikeQuestion(ctx *fiber.Ctx) error {
+func (controller *RoomController) EditQuestion(ctx *fiber.Ctx) error {
 	roomID := ctx.Params("roomID")
 	questionID := ctx.Params("questionID")
 
-	var like entities.Like
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	var question dtos.EditQuestionDTO
 
-	err := ctx.BodyParser(&like)
+	err = ctx.BodyParser(&question)
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
-	errors := controller.validator.ValidateStruct(like)
+	errors := controller.validator.ValidateStruct(question)
 	if errors != nil {
 		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errors)
 	}
 
-	room, err := controller.roomService.LikeQuestion(roomID, questionID, like)
+	room, err := controller.roomService.EditQuestion(userID, roomID, questionID, question)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(room)
+}
+
+func (controller *RoomController) FindQuestionRevisions(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomID")
+	questionID := ctx.Params("questionID")
+
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	revisions, err := controller.roomService.FindQuestionRevisions(userID, roomID, questionID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(revisions)
+}
+
+func (controller *RoomController) LikeQuestion(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomID")
+	questionID := ctx.Params("questionID")
+
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	room, err := controller.roomService.LikeQuestion(userID, roomID, questionID, controller.roomGrant(ctx))
 	if err != nil {
 		return err
 	}
@@ -151,7 +398,12 @@ func (controller *RoomController) DeslikeQuestion(ctx *fiber.Ctx) error {
 	questionID := ctx.Params("questionID")
 	likeID := ctx.Params("likeID")
 
-	room, err := controller.roomService.DeslikeQuestion(roomID, questionID, likeID)
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	room, err := controller.roomService.DeslikeQuestion(userID, roomID, questionID, likeID)
 	if err != nil {
 		return err
 	}
@@ -175,3 +427,298 @@ func (controller *RoomController) DeleteQuestion(ctx *fiber.Ctx) error {
 
 	return ctx.JSON(room)
 }
+
+func (controller *RoomController) ApproveQuestion(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomID")
+	questionID := ctx.Params("questionID")
+
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	room, err := controller.roomService.ApproveQuestion(userID, roomID, questionID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(room)
+}
+
+func (controller *RoomController) RejectQuestion(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomID")
+	questionID := ctx.Params("questionID")
+
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	room, err := controller.roomService.RejectQuestion(userID, roomID, questionID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(room)
+}
+
+func (controller *RoomController) CreateReply(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomID")
+	questionID := ctx.Params("questionID")
+
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	var reply dtos.ReplyDTO
+
+	err = ctx.BodyParser(&reply)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	errors := controller.validator.ValidateStruct(reply)
+	if errors != nil {
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errors)
+	}
+
+	room, err := controller.roomService.CreateReply(userID, roomID, questionID, reply, controller.roomGrant(ctx))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(room)
+}
+
+func (controller *RoomController) UpdateReply(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomID")
+	questionID := ctx.Params("questionID")
+	replyID := ctx.Params("replyID")
+
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	var replyData dtos.UpdateReplyDTO
+
+	err = ctx.BodyParser(&replyData)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	errors := controller.validator.ValidateStruct(replyData)
+	if errors != nil {
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errors)
+	}
+
+	room, err := controller.roomService.UpdateReply(userID, roomID, questionID, replyID, replyData)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(room)
+}
+
+func (controller *RoomController) DeleteReply(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomID")
+	questionID := ctx.Params("questionID")
+	replyID := ctx.Params("replyID")
+
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	room, err := controller.roomService.DeleteReply(userID, roomID, questionID, replyID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(room)
+}
+
+func (controller *RoomController) CreatePoll(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomID")
+
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	var poll dtos.PollDTO
+
+	err = ctx.BodyParser(&poll)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	errors := controller.validator.ValidateStruct(poll)
+	if errors != nil {
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errors)
+	}
+
+	room, err := controller.roomService.CreatePoll(userID, roomID, poll)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(room)
+}
+
+func (controller *RoomController) OpenPoll(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomID")
+	pollID := ctx.Params("pollID")
+
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	room, err := controller.roomService.OpenPoll(userID, roomID, pollID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(room)
+}
+
+func (controller *RoomController) ClosePoll(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomID")
+	pollID := ctx.Params("pollID")
+
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	room, err := controller.roomService.ClosePoll(userID, roomID, pollID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(room)
+}
+
+func (controller *RoomController) Vote(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomID")
+	pollID := ctx.Params("pollID")
+
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	var vote dtos.VoteDTO
+
+	err = ctx.BodyParser(&vote)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	errors := controller.validator.ValidateStruct(vote)
+	if errors != nil {
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errors)
+	}
+
+	room, err := controller.roomService.Vote(userID, roomID, pollID, vote, controller.roomGrant(ctx))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(room)
+}
+
+func (controller *RoomController) UpdateSettings(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomID")
+
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	var settingsDTO dtos.RoomSettingsDTO
+
+	err = ctx.BodyParser(&settingsDTO)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	errors := controller.validator.ValidateStruct(settingsDTO)
+	if errors != nil {
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errors)
+	}
+
+	room, err := controller.roomService.UpdateSettings(userID, roomID, settingsDTO)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(room)
+}
+
+func (controller *RoomController) AddMember(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomID")
+
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	var memberDTO dtos.MemberDTO
+
+	err = ctx.BodyParser(&memberDTO)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	errors := controller.validator.ValidateStruct(memberDTO)
+	if errors != nil {
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errors)
+	}
+
+	room, err := controller.roomService.AddMember(userID, roomID, memberDTO)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(room)
+}
+
+func (controller *RoomController) RemoveMember(ctx *fiber.Ctx) error {
+	roomID := ctx.Params("roomID")
+	memberID := ctx.Params("memberID")
+
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	room, err := controller.roomService.RemoveMember(userID, roomID, memberID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(room)
+}
+
+// roomGrant reads the grant to enter a private room, which is sent in the
+// query string where headers can not be set, such as when opening the feed.
+func (controller *RoomController) roomGrant(ctx *fiber.Ctx) string {
+	if grant := ctx.Get("X-Room-Grant"); grant != "" {
+		return grant
+	}
+
+	return ctx.Query("grant")
+}
+
+// viewerID identifies who is reading a public route, which is nobody when the
+// request came without a token.
+func (controller *RoomController) viewerID(ctx *fiber.Ctx) (string, error) {
+	if ctx.Locals("user") == nil {
+		return "", nil
+	}
+
+	return controller.authenticator.ExtractUserID(ctx.Locals("user"))
+}
@@ -0,0 +1,262 @@
+package controllers
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/fasthttp/websocket"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/valyala/fasthttp"
+	"github.com/waliqueiroz/letmeask-api/internal/application/dtos"
+)
+
+const (
+	feedWriteTimeout        = 10 * time.Second
+	feedPingInterval        = 30 * time.Second
+	streamHeartbeatInterval = 15 * time.Second
+	streamRetryInterval     = 3 * time.Second
+)
+
+var feedUpgrader = websocket.FastHTTPUpgrader{
+	CheckOrigin: func(ctx *fasthttp.RequestCtx) bool {
+		return true
+	},
+}
+
+func (controller *RoomController) Feed(ctx *fiber.Ctx) error {
+	if !websocket.FastHTTPIsWebSocketUpgrade(ctx.Context()) {
+		return fiber.ErrUpgradeRequired
+	}
+
+	roomID := utils.CopyString(ctx.Params("roomID"))
+
+	viewerID, err := controller.viewerID(ctx)
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	grant := controller.roomGrant(ctx)
+
+	// Only rooms the viewer may see get a subscription, or anyone could make
+	// the broadcaster keep track of rooms that do not exist.
+	if _, err := controller.roomService.FindSummaryByID(viewerID, roomID, grant); err != nil {
+		return err
+	}
+
+	// Subscribing before loading the snapshot guarantees that no event
+	// published in between is lost.
+	events, unsubscribe := controller.broadcaster.Subscribe(roomID)
+
+	room, err := controller.roomService.FindByID(viewerID, roomID, grant)
+	if err != nil {
+		unsubscribe()
+		return err
+	}
+
+	snapshot := dtos.RoomEventDTO{
+		Type:      dtos.RoomSnapshotEvent,
+		RoomID:    roomID,
+		Data:      room,
+		CreatedAt: time.Now(),
+	}
+
+	err = feedUpgrader.Upgrade(ctx.Context(), func(conn *websocket.Conn) {
+		defer unsubscribe()
+		streamFeed(conn, snapshot, events)
+	})
+	if err != nil {
+		unsubscribe()
+		return err
+	}
+
+	return nil
+}
+
+func streamFeed(conn *websocket.Conn, snapshot dtos.RoomEventDTO, events <-chan dtos.RoomEventDTO) {
+	defer conn.Close()
+
+	disconnected := make(chan struct{})
+	go func() {
+		defer close(disconnected)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(feedPingInterval)
+	defer ticker.Stop()
+
+	if err := writeFeedEvent(conn, snapshot); err != nil {
+		return
+	}
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				message := websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "")
+				conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(feedWriteTimeout))
+				return
+			}
+
+			if err := writeFeedEvent(conn, event); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(feedWriteTimeout)); err != nil {
+				return
+			}
+		case <-disconnected:
+			return
+		}
+	}
+}
+
+func writeFeedEvent(conn *websocket.Conn, event dtos.RoomEventDTO) error {
+	conn.SetWriteDeadline(time.Now().Add(feedWriteTimeout))
+	return conn.WriteJSON(event)
+}
+
+func (controller *RoomController) Stream(ctx *fiber.Ctx) error {
+	roomID := utils.CopyString(ctx.Params("roomID"))
+
+	viewerID, err := controller.viewerID(ctx)
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	grant := controller.roomGrant(ctx)
+
+	// Only rooms the viewer may see get a subscription, and resuming skips
+	// the snapshot, but not the check that the viewer may still see the room.
+	if _, err := controller.roomService.FindSummaryByID(viewerID, roomID, grant); err != nil {
+		return err
+	}
+
+	events, unsubscribe := controller.broadcaster.Subscribe(roomID)
+
+	var backlog []dtos.RoomEventDTO
+	replayed := false
+
+	if lastEventID, ok := lastEventID(ctx); ok {
+		backlog, replayed = controller.broadcaster.History(roomID, lastEventID)
+	}
+
+	// When there is nothing to resume from, or the missed events already fell
+	// out of the buffer, the client starts over from a fresh snapshot.
+	if !replayed {
+		room, err := controller.roomService.FindByID(viewerID, roomID, grant)
+		if err != nil {
+			unsubscribe()
+			return err
+		}
+
+		backlog = []dtos.RoomEventDTO{{
+			Type:      dtos.RoomSnapshotEvent,
+			RoomID:    roomID,
+			Data:      room,
+			CreatedAt: time.Now(),
+		}}
+	}
+
+	ctx.Set(fiber.HeaderContentType, "text/event-stream")
+	ctx.Set(fiber.HeaderCacheControl, "no-cache")
+	ctx.Set(fiber.HeaderConnection, "keep-alive")
+	ctx.Set("X-Accel-Buffering", "no")
+
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer unsubscribe()
+		streamEvents(w, backlog, events)
+	})
+
+	return nil
+}
+
+func lastEventID(ctx *fiber.Ctx) (int64, bool) {
+	value := ctx.Get("Last-Event-ID")
+	if value == "" {
+		value = ctx.Query("last_event_id")
+	}
+
+	if value == "" {
+		return 0, false
+	}
+
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || id < 0 {
+		return 0, false
+	}
+
+	return id, true
+}
+
+func streamEvents(w *bufio.Writer, backlog []dtos.RoomEventDTO, events <-chan dtos.RoomEventDTO) {
+	var lastSentID int64
+
+	fmt.Fprintf(w, "retry: %d\n\n", streamRetryInterval.Milliseconds())
+
+	for _, event := range backlog {
+		if err := writeStreamEvent(w, event); err != nil {
+			return
+		}
+		if event.ID > lastSentID {
+			lastSentID = event.ID
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return
+	}
+
+	ticker := time.NewTicker(streamHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			// Events published while the backlog was being loaded may be
+			// delivered twice, once from the history and once live.
+			if event.ID <= lastSentID {
+				continue
+			}
+
+			if err := writeStreamEvent(w, event); err != nil {
+				return
+			}
+			lastSentID = event.ID
+		case <-ticker.C:
+			if _, err := w.WriteString(": ping\n\n"); err != nil {
+				return
+			}
+		}
+
+		if err := w.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func writeStreamEvent(w *bufio.Writer, event dtos.RoomEventDTO) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	if event.ID > 0 {
+		fmt.Fprintf(w, "id: %d\n", event.ID)
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+
+	return err
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/golang/mock/gomock"
@@ -15,9 +16,12 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/waliqueiroz/letmeask-api/internal/application/dtos"
+	application "github.com/waliqueiroz/letmeask-api/internal/application/errors"
 	"github.com/waliqueiroz/letmeask-api/internal/application/services/mocks"
 	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
+	domain "github.com/waliqueiroz/letmeask-api/internal/domain/errors"
 	authMocks "github.com/waliqueiroz/letmeask-api/internal/infrastructure/authentication/mocks"
+	broadcastingMocks "github.com/waliqueiroz/letmeask-api/internal/infrastructure/broadcasting/mocks"
 	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/http/fiber/controllers"
 	infrastructure "github.com/waliqueiroz/letmeask-api/internal/infrastructure/http/fiber/errors"
 	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/http/fiber/routes"
@@ -38,7 +42,7 @@ var _ = Describe("Room", func() {
 				ErrorHandler: infrastructure.Handler,
 			})
 
-			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
 
 			req := httptest.NewRequest(fiber.MethodPost, routes.CREATE_ROOM_ROUTE, input)
 			req.Header.Set("Content-Type", "application/json")
@@ -60,8 +64,8 @@ var _ = Describe("Room", func() {
 				err = json.Unmarshal(roomSerialized, &expectedCreateResult)
 				Expect(err).NotTo(HaveOccurred())
 
-				var room entities.Room
-				err = json.Unmarshal(createRoomRequestSerialized, &room)
+				var roomDTO dtos.RoomDTO
+				err = json.Unmarshal(createRoomRequestSerialized, &roomDTO)
 				Expect(err).NotTo(HaveOccurred())
 
 				input = bytes.NewBuffer(createRoomRequestSerialized)
@@ -69,13 +73,16 @@ var _ = Describe("Room", func() {
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(expectedCreateResult.Author.ID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
-				mockRoomService.EXPECT().Create(room).Return(expectedCreateResult, nil).Times(1)
+				mockRoomService.EXPECT().Create(expectedCreateResult.Author.ID, roomDTO).Return(expectedCreateResult, nil).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
 			It("response status code should be equal to 201 Created", func() {
@@ -108,12 +115,15 @@ var _ = Describe("Room", func() {
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return("61641b096beb85adb0e5d297", nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
 			It("response status code should be equal to 422 Unprocessable Entity", func() {
@@ -132,12 +142,15 @@ var _ = Describe("Room", func() {
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return("61641b096beb85adb0e5d297", nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
 			It("response status code should be equal to 400 Bad Request", func() {
@@ -154,8 +167,8 @@ var _ = Describe("Room", func() {
 				createRoomRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/create_room_request.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				var room entities.Room
-				err = json.Unmarshal(createRoomRequestSerialized, &room)
+				var roomDTO dtos.RoomDTO
+				err = json.Unmarshal(createRoomRequestSerialized, &roomDTO)
 				Expect(err).NotTo(HaveOccurred())
 
 				input = bytes.NewBuffer(createRoomRequestSerialized)
@@ -163,13 +176,16 @@ var _ = Describe("Room", func() {
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return("61641b096beb85adb0e5d297", nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
-				mockRoomService.EXPECT().Create(room).Return(entities.Room{}, errors.New("an error")).Times(1)
+				mockRoomService.EXPECT().Create("61641b096beb85adb0e5d297", roomDTO).Return(entities.Room{}, errors.New("an error")).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
 			It("response status code should be equal to 500 Internal Server Error", func() {
@@ -180,6 +196,72 @@ var _ = Describe("Room", func() {
 				mockCtrl.Finish()
 			})
 		})
+
+		When("the user has not verified the email yet", func() {
+			BeforeEach(func() {
+				createRoomRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/create_room_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var roomDTO dtos.RoomDTO
+				err = json.Unmarshal(createRoomRequestSerialized, &roomDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				input = bytes.NewBuffer(createRoomRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return("61641b096beb85adb0e5d297", nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().Create("61641b096beb85adb0e5d297", roomDTO).Return(entities.Room{}, application.NewForbiddenError("confirme seu e-mail antes de criar uma sala.")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusForbidden))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user is not authenticated", func() {
+			BeforeEach(func() {
+				createRoomRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/create_room_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				input = bytes.NewBuffer(createRoomRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return("", application.NewUnauthorizedError("token inválido")).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnauthorized))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
 	})
 
 	Describe("Ending a room", func() {
@@ -195,7 +277,7 @@ var _ = Describe("Room", func() {
 				ErrorHandler: infrastructure.Handler,
 			})
 
-			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
 
 			route := strings.Replace(routes.END_ROOM_ROUTE, ":roomID", roomID, 1)
 
@@ -223,12 +305,14 @@ var _ = Describe("Room", func() {
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
 
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
 				mockRoomService.EXPECT().EndRoom(userID, roomID).Return(expectedEndRoomResult, nil).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
 			It("response status code should be equal to 200 OK", func() {
@@ -260,11 +344,13 @@ var _ = Describe("Room", func() {
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return("", errors.New("an error")).Times(1)
 
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
 			It("response status code should be equal to 401 Unauthorized", func() {
@@ -286,12 +372,14 @@ var _ = Describe("Room", func() {
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
 
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
 				mockRoomService.EXPECT().EndRoom(userID, roomID).Return(entities.Room{}, errors.New("an error")).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
 			It("response status code should be equal to 500 Internal Server Error", func() {
@@ -304,7 +392,7 @@ var _ = Describe("Room", func() {
 		})
 	})
 
-	Describe("Finding a room by ID", func() {
+	Describe("Reopening a room", func() {
 		var roomID string
 		var response *http.Response
 		var mockCtrl *gomock.Controller
@@ -317,45 +405,49 @@ var _ = Describe("Room", func() {
 				ErrorHandler: infrastructure.Handler,
 			})
 
-			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
 
-			route := strings.Replace(routes.FIND_ROOM_BY_ID_ROUTE, ":roomID", roomID, 1)
+			route := strings.Replace(routes.REOPEN_ROOM_ROUTE, ":roomID", roomID, 1)
 
-			req := httptest.NewRequest(fiber.MethodGet, route, nil)
+			req := httptest.NewRequest(fiber.MethodPost, route, nil)
 
 			response, err = app.Test(req)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
-		When("find a room by ID with success", func() {
-			var expectedFindByIDResult entities.Room
+		When("reopen room with success", func() {
+			var expectedReopenRoomResult entities.Room
 
 			BeforeEach(func() {
 				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_questions.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedReopenRoomResult)
 				Expect(err).NotTo(HaveOccurred())
 
 				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID := "621f5e02e07fdbb81c8221f5"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
-				mockRoomService.EXPECT().FindByID(roomID).Return(expectedFindByIDResult, nil).Times(1)
+				mockRoomService.EXPECT().ReopenRoom(userID, roomID).Return(expectedReopenRoomResult, nil).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
 			It("response status code should be equal to 200 OK", func() {
 				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
 			})
 
-			It("response body should be equal to roomService.FindByID result", func() {
+			It("response body should be equal to roomService.ReopenRoom result", func() {
 				body, err := ioutil.ReadAll(response.Body)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -363,7 +455,7 @@ var _ = Describe("Room", func() {
 				err = json.Unmarshal(body, &room)
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(room).To(Equal(expectedFindByIDResult))
+				Expect(room).To(Equal(expectedReopenRoomResult))
 			})
 
 			AfterEach(func() {
@@ -371,24 +463,28 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("a general error occurs while finding a room by ID", func() {
+		When("the user is not the owner of the room", func() {
 			BeforeEach(func() {
 				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID := "6117e377b6e7bae09f52c483"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
-				mockRoomService.EXPECT().FindByID(roomID).Return(entities.Room{}, errors.New("an error")).Times(1)
+				mockRoomService.EXPECT().ReopenRoom(userID, roomID).Return(entities.Room{}, application.NewForbiddenError("somente o dono da sala pode reabri-la.")).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
-			It("response status code should be equal to 500 Internal Server Error", func() {
-				Expect(response.StatusCode).To(Equal(fiber.StatusInternalServerError))
+			It("response status code should be equal to 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusForbidden))
 			})
 
 			AfterEach(func() {
@@ -397,9 +493,8 @@ var _ = Describe("Room", func() {
 		})
 	})
 
-	Describe("Creating a question", func() {
-		var roomID string
-		var input *bytes.Buffer
+	Describe("Listing rooms", func() {
+		var route string
 		var response *http.Response
 		var mockCtrl *gomock.Controller
 		var roomController *controllers.RoomController
@@ -411,63 +506,60 @@ var _ = Describe("Room", func() {
 				ErrorHandler: infrastructure.Handler,
 			})
 
-			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, roomController)
-
-			route := strings.Replace(routes.CREATE_QUESTION_ROUTE, ":roomID", roomID, 1)
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
 
-			req := httptest.NewRequest(fiber.MethodPost, route, input)
-			req.Header.Set("Content-Type", "application/json")
+			req := httptest.NewRequest(fiber.MethodGet, route, nil)
 
 			response, err = app.Test(req)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
-		When("create a question with success", func() {
-			var expectedCreateQuestionResult entities.Room
+		When("list rooms with success", func() {
+			var expectedFindAllResult dtos.RoomPageDTO
 
 			BeforeEach(func() {
-				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/create_question_request.json")
-				Expect(err).NotTo(HaveOccurred())
-
-				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_questions.json")
-				Expect(err).NotTo(HaveOccurred())
-
-				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedCreateQuestionResult)
+				roomSerialized, err := ioutil.ReadFile("../../../../../test/resources/room.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				var question entities.Question
-				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				var room entities.Room
+				err = json.Unmarshal(roomSerialized, &room)
 				Expect(err).NotTo(HaveOccurred())
 
-				roomID = "621f5ec1e07fdbb81c8221f7"
+				expectedFindAllResult = dtos.RoomPageDTO{
+					Rooms:      []entities.Room{room},
+					NextCursor: "next",
+				}
 
-				input = bytes.NewBuffer(createQuestionRequestSerialized)
+				route = routes.FIND_ALL_ROOMS_ROUTE + "?status=open&sort=-title&limit=10"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return("6117e377b6e7bae09f52c483", nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
-				mockRoomService.EXPECT().CreateQuestion(roomID, question).Return(expectedCreateQuestionResult, nil).Times(1)
+				mockRoomService.EXPECT().FindAll("6117e377b6e7bae09f52c483", dtos.RoomQueryDTO{Status: "open", Sort: "-title", Limit: 10}).Return(expectedFindAllResult, nil).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
-			It("response status code should be equal to 201 Created", func() {
-				Expect(response.StatusCode).To(Equal(fiber.StatusCreated))
+			It("response status code should be equal to 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
 			})
 
-			It("response body should be equal to roomService.CreateQuestion result", func() {
+			It("response body should be equal to roomService.FindAll result", func() {
 				body, err := ioutil.ReadAll(response.Body)
 				Expect(err).NotTo(HaveOccurred())
 
-				var room entities.Room
-				err = json.Unmarshal(body, &room)
+				var page dtos.RoomPageDTO
+				err = json.Unmarshal(body, &page)
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(room).To(Equal(expectedCreateQuestionResult))
+				Expect(page).To(Equal(expectedFindAllResult))
 			})
 
 			AfterEach(func() {
@@ -475,24 +567,49 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("validation fails while creating a question", func() {
+		When("list the rooms of a user with success", func() {
 			BeforeEach(func() {
-				createQuestionIncompleteRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/create_question_request_incomplete.json")
-				Expect(err).NotTo(HaveOccurred())
+				route = strings.Replace(routes.FIND_ROOMS_BY_AUTHOR_ROUTE, ":userID", "621f5e02e07fdbb81c8221f5", 1)
 
-				roomID = "621f5ec1e07fdbb81c8221f7"
+				mockCtrl = gomock.NewController(GinkgoT())
 
-				input = bytes.NewBuffer(createQuestionIncompleteRequestSerialized)
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return("6117e377b6e7bae09f52c483", nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().FindAll("6117e377b6e7bae09f52c483", dtos.RoomQueryDTO{AuthorID: "621f5e02e07fdbb81c8221f5"}).Return(dtos.RoomPageDTO{Rooms: []entities.Room{}}, nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("validation fails while listing rooms", func() {
+			BeforeEach(func() {
+				route = routes.FIND_ALL_ROOMS_ROUTE + "?status=archived&created_from=yesterday"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
 
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
 			It("response status code should be equal to 422 Unprocessable Entity", func() {
@@ -504,21 +621,21 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("request body comes with an invalid payload", func() {
+		When("query string comes with an invalid limit", func() {
 			BeforeEach(func() {
-				roomID = "621f5ec1e07fdbb81c8221f7"
-
-				input = bytes.NewBuffer(nil)
+				route = routes.FIND_ALL_ROOMS_ROUTE + "?limit=ten"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
 
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
 			It("response status code should be equal to 400 Bad Request", func() {
@@ -530,29 +647,23 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("a general error occurs while creating question", func() {
+		When("a general error occurs while listing rooms", func() {
 			BeforeEach(func() {
-				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/create_question_request.json")
-				Expect(err).NotTo(HaveOccurred())
-
-				var question entities.Question
-				err = json.Unmarshal(createQuestionRequestSerialized, &question)
-				Expect(err).NotTo(HaveOccurred())
-
-				roomID = "621f5ec1e07fdbb81c8221f7"
-
-				input = bytes.NewBuffer(createQuestionRequestSerialized)
+				route = routes.FIND_ALL_ROOMS_ROUTE
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return("6117e377b6e7bae09f52c483", nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
-				mockRoomService.EXPECT().CreateQuestion(roomID, question).Return(entities.Room{}, errors.New("an error")).Times(1)
+				mockRoomService.EXPECT().FindAll("6117e377b6e7bae09f52c483", dtos.RoomQueryDTO{}).Return(dtos.RoomPageDTO{}, errors.New("an error")).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
 			It("response status code should be equal to 500 Internal Server Error", func() {
@@ -565,10 +676,9 @@ var _ = Describe("Room", func() {
 		})
 	})
 
-	Describe("Updating a question", func() {
+	Describe("Finding a room by ID", func() {
 		var roomID string
-		var questionID string
-		var input *bytes.Buffer
+		var query string
 		var response *http.Response
 		var mockCtrl *gomock.Controller
 		var roomController *controllers.RoomController
@@ -580,59 +690,48 @@ var _ = Describe("Room", func() {
 				ErrorHandler: infrastructure.Handler,
 			})
 
-			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
 
-			route := strings.Replace(routes.UPDATE_QUESTION_ROUTE, ":roomID", roomID, 1)
-			route = strings.Replace(route, ":questionID", questionID, 1)
+			route := strings.Replace(routes.FIND_ROOM_BY_ID_ROUTE, ":roomID", roomID, 1)
 
-			req := httptest.NewRequest(fiber.MethodPatch, route, input)
-			req.Header.Set("Content-Type", "application/json")
+			req := httptest.NewRequest(fiber.MethodGet, route+query, nil)
 
 			response, err = app.Test(req)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
-		When("highlight question with success", func() {
-			var expectedUpdateQuestionResult entities.Room
+		When("find a room by ID with success", func() {
+			var expectedFindByIDResult entities.Room
 
 			BeforeEach(func() {
-				highlightQuestionRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/highlight_question_request.json")
-				Expect(err).NotTo(HaveOccurred())
-
-				roomWithQuestionHighlightedSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_question_highlighted.json")
-				Expect(err).NotTo(HaveOccurred())
-
-				err = json.Unmarshal(roomWithQuestionHighlightedSerialized, &expectedUpdateQuestionResult)
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_questions.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				var questionData dtos.UpdateQuestionDTO
-				err = json.Unmarshal(highlightQuestionRequestSerialized, &questionData)
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedFindByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c8221f9"
-				userID := "621f5e02e07fdbb81c8221f5"
-
-				input = bytes.NewBuffer(highlightQuestionRequestSerialized)
+				query = ""
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
-				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
-				mockRoomService.EXPECT().UpdateQuestion(userID, roomID, questionID, questionData).Return(expectedUpdateQuestionResult, nil).Times(1)
+				mockRoomService.EXPECT().FindByID("", roomID, "").Return(expectedFindByIDResult, nil).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
 			It("response status code should be equal to 200 OK", func() {
 				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
 			})
 
-			It("response body should be equal to roomService.UpdateQuestion result", func() {
+			It("response body should be equal to roomService.FindByID result", func() {
 				body, err := ioutil.ReadAll(response.Body)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -640,7 +739,7 @@ var _ = Describe("Room", func() {
 				err = json.Unmarshal(body, &room)
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(room).To(Equal(expectedUpdateQuestionResult))
+				Expect(room).To(Equal(expectedFindByIDResult))
 			})
 
 			AfterEach(func() {
@@ -648,47 +747,40 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("mark question as answered with success", func() {
-			var expectedUpdateQuestionResult entities.Room
+		When("find a room summary by ID with success", func() {
+			var expectedFindSummaryByIDResult entities.Room
 
 			BeforeEach(func() {
-				markQuestionAsAnsweredRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/mark_question_as_answered_request.json")
-				Expect(err).NotTo(HaveOccurred())
-
-				roomWithQuestionAnsweredSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_question_answered.json")
+				roomSerialized, err := ioutil.ReadFile("../../../../../test/resources/room.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				err = json.Unmarshal(roomWithQuestionAnsweredSerialized, &expectedUpdateQuestionResult)
+				err = json.Unmarshal(roomSerialized, &expectedFindSummaryByIDResult)
 				Expect(err).NotTo(HaveOccurred())
 
-				var questionData dtos.UpdateQuestionDTO
-				err = json.Unmarshal(markQuestionAsAnsweredRequestSerialized, &questionData)
-				Expect(err).NotTo(HaveOccurred())
+				expectedFindSummaryByIDResult.QuestionCount = 3
 
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c8221f9"
-				userID := "621f5e02e07fdbb81c8221f5"
-
-				input = bytes.NewBuffer(markQuestionAsAnsweredRequestSerialized)
+				query = "?summary=true"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
-				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
-				mockRoomService.EXPECT().UpdateQuestion(userID, roomID, questionID, questionData).Return(expectedUpdateQuestionResult, nil).Times(1)
+				mockRoomService.EXPECT().FindSummaryByID("", roomID, "").Return(expectedFindSummaryByIDResult, nil).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
 			It("response status code should be equal to 200 OK", func() {
 				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
 			})
 
-			It("response body should be equal to roomService.UpdateQuestion result", func() {
+			It("response body should be equal to roomService.FindSummaryByID result", func() {
 				body, err := ioutil.ReadAll(response.Body)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -696,7 +788,7 @@ var _ = Describe("Room", func() {
 				err = json.Unmarshal(body, &room)
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(room).To(Equal(expectedUpdateQuestionResult))
+				Expect(room).To(Equal(expectedFindSummaryByIDResult))
 			})
 
 			AfterEach(func() {
@@ -704,88 +796,2423 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("validation fails while updating question", func() {
+		When("a general error occurs while finding a room by ID", func() {
 			BeforeEach(func() {
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c8221f9"
-				userID := "621f5e02e07fdbb81c8221f5"
-
-				input = bytes.NewBuffer([]byte(`{}`))
+				query = ""
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
-				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().FindByID("", roomID, "").Return(entities.Room{}, errors.New("an error")).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
-			It("response status code should be equal to 422 Unprocessable Entity", func() {
-				Expect(response.StatusCode).To(Equal(fiber.StatusUnprocessableEntity))
+			It("response status code should be equal to 500 Internal Server Error", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusInternalServerError))
 			})
 
 			AfterEach(func() {
 				mockCtrl.Finish()
 			})
 		})
+	})
+
+	Describe("Finding a room by code", func() {
+		var code string
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			route := strings.Replace(routes.FIND_ROOM_BY_CODE_ROUTE, ":code", code, 1)
+
+			req := httptest.NewRequest(fiber.MethodGet, route, nil)
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("find a room by code with success", func() {
+			var expectedFindByCodeResult entities.Room
 
-		When("request body comes with an invalid payload", func() {
 			BeforeEach(func() {
-				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c8221f9"
-				userID := "621f5e02e07fdbb81c8221f5"
+				roomSerialized, err := ioutil.ReadFile("../../../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
 
-				input = bytes.NewBuffer(nil)
+				err = json.Unmarshal(roomSerialized, &expectedFindByCodeResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				code = "K7QM2X"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
-				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().FindByCode("", code, "").Return(expectedFindByCodeResult, nil).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
-			It("response status code should be equal to 400 Bad Request", func() {
-				Expect(response.StatusCode).To(Equal(fiber.StatusBadRequest))
+			It("response status code should be equal to 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
 			})
 
-			AfterEach(func() {
-				mockCtrl.Finish()
+			It("response body should be equal to roomService.FindByCode result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(body, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(room).To(Equal(expectedFindByCodeResult))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("no room has the given code", func() {
+			BeforeEach(func() {
+				code = "ZZZZZZ"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().FindByCode("", code, "").Return(entities.Room{}, domain.NewResourceNotFoundError("sala não encontrada")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 404 Not Found", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusNotFound))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Joining a private room", func() {
+		var roomID string
+		var input *bytes.Buffer
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			route := strings.Replace(routes.JOIN_ROOM_ROUTE, ":roomID", roomID, 1)
+
+			req := httptest.NewRequest(fiber.MethodPost, route, input)
+			req.Header.Set("Content-Type", "application/json")
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the passcode is right", func() {
+			var expectedJoinRoomResult dtos.RoomGrantDTO
+
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				input = bytes.NewBufferString(`{"passcode": "1234"}`)
+
+				expectedJoinRoomResult = dtos.RoomGrantDTO{Grant: "grant", ExpiresAt: time.Date(2022, 3, 2, 12, 0, 0, 0, time.UTC)}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().JoinRoom("", roomID, dtos.JoinRoomDTO{Passcode: "1234"}).Return(expectedJoinRoomResult, nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
+			})
+
+			It("response body should be equal to roomService.JoinRoom result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var grant dtos.RoomGrantDTO
+				err = json.Unmarshal(body, &grant)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(grant).To(Equal(expectedJoinRoomResult))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the passcode is wrong", func() {
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				input = bytes.NewBufferString(`{"passcode": "4321"}`)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().JoinRoom("", roomID, dtos.JoinRoomDTO{Passcode: "4321"}).Return(dtos.RoomGrantDTO{}, application.NewForbiddenError("código de acesso inválido.")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusForbidden))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Listing the questions of a room", func() {
+		var route string
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			req := httptest.NewRequest(fiber.MethodGet, route, nil)
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("list questions with success", func() {
+			var expectedFindQuestionsResult dtos.QuestionPageDTO
+
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var roomWithQuestions entities.Room
+				err = json.Unmarshal(roomWithQuestionsSerialized, &roomWithQuestions)
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedFindQuestionsResult = dtos.QuestionPageDTO{
+					Questions:  roomWithQuestions.Questions,
+					NextCursor: "next",
+				}
+
+				roomID := "621f5ec1e07fdbb81c8221f7"
+				isHighlighted := true
+
+				route = strings.Replace(routes.FIND_QUESTIONS_ROUTE, ":roomID", roomID, 1) + "?order=most_liked&is_highlighted=true&limit=10"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().FindQuestions("", roomID, dtos.QuestionQueryDTO{Order: "most_liked", IsHighlighted: &isHighlighted, Limit: 10}, "").Return(expectedFindQuestionsResult, nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
+			})
+
+			It("response body should be equal to roomService.FindQuestions result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var page dtos.QuestionPageDTO
+				err = json.Unmarshal(body, &page)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(page).To(Equal(expectedFindQuestionsResult))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("validation fails while listing questions", func() {
+			BeforeEach(func() {
+				route = strings.Replace(routes.FIND_QUESTIONS_ROUTE, ":roomID", "621f5ec1e07fdbb81c8221f7", 1) + "?order=random"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 422 Unprocessable Entity", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnprocessableEntity))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("query string comes with an invalid filter", func() {
+			BeforeEach(func() {
+				route = strings.Replace(routes.FIND_QUESTIONS_ROUTE, ":roomID", "621f5ec1e07fdbb81c8221f7", 1) + "?is_answered=maybe"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 400 Bad Request", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusBadRequest))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("a general error occurs while listing questions", func() {
+			BeforeEach(func() {
+				roomID := "621f5ec1e07fdbb81c8221f7"
+
+				route = strings.Replace(routes.FIND_QUESTIONS_ROUTE, ":roomID", roomID, 1)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().FindQuestions("", roomID, dtos.QuestionQueryDTO{}, "").Return(dtos.QuestionPageDTO{}, errors.New("an error")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 500 Internal Server Error", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusInternalServerError))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Connecting to the room feed", func() {
+		var roomID string
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			route := strings.Replace(routes.ROOM_FEED_ROUTE, ":roomID", roomID, 1)
+
+			req := httptest.NewRequest(fiber.MethodGet, route, nil)
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the request is not a websocket upgrade", func() {
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 426 Upgrade Required", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUpgradeRequired))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Streaming room events", func() {
+		var roomID string
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			route := strings.Replace(routes.ROOM_EVENTS_ROUTE, ":roomID", roomID, 1)
+
+			req := httptest.NewRequest(fiber.MethodGet, route, nil)
+			req.Header.Set("Last-Event-ID", "42")
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the missed events are no longer buffered and the snapshot cannot be loaded", func() {
+			var unsubscribed bool
+
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				unsubscribed = false
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				var events <-chan dtos.RoomEventDTO = make(chan dtos.RoomEventDTO)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+				mockBroadcaster.EXPECT().Subscribe(roomID).Return(events, func() { unsubscribed = true }).Times(1)
+				mockBroadcaster.EXPECT().History(roomID, int64(42)).Return(nil, false).Times(1)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().FindSummaryByID("", roomID, "").Return(entities.Room{ID: roomID}, nil).Times(1)
+				mockRoomService.EXPECT().FindByID("", roomID, "").Return(entities.Room{}, domain.NewResourceNotFoundError("sala não encontrada")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 404 Not Found", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusNotFound))
+			})
+
+			It("should release the subscription", func() {
+				Expect(unsubscribed).To(BeTrue())
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the room does not exist", func() {
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().FindSummaryByID("", roomID, "").Return(entities.Room{}, domain.NewResourceNotFoundError("sala não encontrada")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 404 Not Found", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusNotFound))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Creating a question", func() {
+		var roomID string
+		var input *bytes.Buffer
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			route := strings.Replace(routes.CREATE_QUESTION_ROUTE, ":roomID", roomID, 1)
+
+			req := httptest.NewRequest(fiber.MethodPost, route, input)
+			req.Header.Set("Content-Type", "application/json")
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("create a question with success", func() {
+			var expectedCreateQuestionResult entities.Room
+
+			BeforeEach(func() {
+				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/create_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedCreateQuestionResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				var question dtos.QuestionDTO
+				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID := "6117e377b6e7bae09f52c483"
+
+				input = bytes.NewBuffer(createQuestionRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().CreateQuestion(userID, roomID, question, "").Return(expectedCreateQuestionResult, nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 201 Created", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusCreated))
+			})
+
+			It("response body should be equal to roomService.CreateQuestion result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(body, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(room).To(Equal(expectedCreateQuestionResult))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("validation fails while creating a question", func() {
+			BeforeEach(func() {
+				createQuestionIncompleteRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/create_question_request_incomplete.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID := "6117e377b6e7bae09f52c483"
+
+				input = bytes.NewBuffer(createQuestionIncompleteRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 422 Unprocessable Entity", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnprocessableEntity))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("request body comes with an invalid payload", func() {
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID := "6117e377b6e7bae09f52c483"
+
+				input = bytes.NewBuffer(nil)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 400 Bad Request", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusBadRequest))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while extracting user ID", func() {
+			BeforeEach(func() {
+				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/create_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+
+				input = bytes.NewBuffer(createQuestionRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return("", errors.New("an error")).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnauthorized))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("a general error occurs while creating question", func() {
+			BeforeEach(func() {
+				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/create_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var question dtos.QuestionDTO
+				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID := "6117e377b6e7bae09f52c483"
+
+				input = bytes.NewBuffer(createQuestionRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().CreateQuestion(userID, roomID, question, "").Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 500 Internal Server Error", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusInternalServerError))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user asks questions too often", func() {
+			BeforeEach(func() {
+				createQuestionRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/create_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var question dtos.QuestionDTO
+				err = json.Unmarshal(createQuestionRequestSerialized, &question)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID := "6117e377b6e7bae09f52c483"
+
+				input = bytes.NewBuffer(createQuestionRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().CreateQuestion(userID, roomID, question, "").Return(entities.Room{}, domain.NewRateLimitError(1500*time.Millisecond, "aguarde um pouco antes de fazer outra pergunta.")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 429 Too Many Requests", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusTooManyRequests))
+			})
+
+			It("response should tell how many seconds to wait", func() {
+				Expect(response.Header.Get(fiber.HeaderRetryAfter)).To(Equal("2"))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Updating a question", func() {
+		var roomID string
+		var questionID string
+		var input *bytes.Buffer
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			route := strings.Replace(routes.UPDATE_QUESTION_ROUTE, ":roomID", roomID, 1)
+			route = strings.Replace(route, ":questionID", questionID, 1)
+
+			req := httptest.NewRequest(fiber.MethodPatch, route, input)
+			req.Header.Set("Content-Type", "application/json")
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("highlight question with success", func() {
+			var expectedUpdateQuestionResult entities.Room
+
+			BeforeEach(func() {
+				highlightQuestionRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/highlight_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionHighlightedSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_question_highlighted.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionHighlightedSerialized, &expectedUpdateQuestionResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				var questionData dtos.UpdateQuestionDTO
+				err = json.Unmarshal(highlightQuestionRequestSerialized, &questionData)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				userID := "621f5e02e07fdbb81c8221f5"
+
+				input = bytes.NewBuffer(highlightQuestionRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().UpdateQuestion(userID, roomID, questionID, questionData).Return(expectedUpdateQuestionResult, nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
+			})
+
+			It("response body should be equal to roomService.UpdateQuestion result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(body, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(room).To(Equal(expectedUpdateQuestionResult))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("mark question as answered with success", func() {
+			var expectedUpdateQuestionResult entities.Room
+
+			BeforeEach(func() {
+				markQuestionAsAnsweredRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/mark_question_as_answered_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionAnsweredSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_question_answered.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionAnsweredSerialized, &expectedUpdateQuestionResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				var questionData dtos.UpdateQuestionDTO
+				err = json.Unmarshal(markQuestionAsAnsweredRequestSerialized, &questionData)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				userID := "621f5e02e07fdbb81c8221f5"
+
+				input = bytes.NewBuffer(markQuestionAsAnsweredRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().UpdateQuestion(userID, roomID, questionID, questionData).Return(expectedUpdateQuestionResult, nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
+			})
+
+			It("response body should be equal to roomService.UpdateQuestion result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(body, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(room).To(Equal(expectedUpdateQuestionResult))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("validation fails while updating question", func() {
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				userID := "621f5e02e07fdbb81c8221f5"
+
+				input = bytes.NewBuffer([]byte(`{}`))
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 422 Unprocessable Entity", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnprocessableEntity))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("request body comes with an invalid payload", func() {
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				userID := "621f5e02e07fdbb81c8221f5"
+
+				input = bytes.NewBuffer(nil)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 400 Bad Request", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusBadRequest))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while extracting user ID", func() {
+			BeforeEach(func() {
+				markQuestionAsAnsweredRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/mark_question_as_answered_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				input = bytes.NewBuffer(markQuestionAsAnsweredRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return("", errors.New("an error")).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnauthorized))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("a general error occurs while updating question", func() {
+			BeforeEach(func() {
+				highlightQuestionRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/highlight_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var questionData dtos.UpdateQuestionDTO
+				err = json.Unmarshal(highlightQuestionRequestSerialized, &questionData)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				userID := "621f5e02e07fdbb81c8221f5"
+
+				input = bytes.NewBuffer(highlightQuestionRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().UpdateQuestion(userID, roomID, questionID, questionData).Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 500 Internal Server Error", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusInternalServerError))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Liking a question", func() {
+		var roomID string
+		var questionID string
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			route := strings.Replace(routes.LIKE_QUESTION_ROUTE, ":roomID", roomID, 1)
+			route = strings.Replace(route, ":questionID", questionID, 1)
+
+			req := httptest.NewRequest(fiber.MethodPost, route, nil)
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("like a question with success", func() {
+			var expectedLikeQuestionResult entities.Room
+
+			BeforeEach(func() {
+				roomWithQuestionLikedSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_question_liked.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionLikedSerialized, &expectedLikeQuestionResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				userID := "6117e377b6e7bae09f52c483"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().LikeQuestion(userID, roomID, questionID, "").Return(expectedLikeQuestionResult, nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
+			})
+
+			It("response body should be equal to roomService.LikeQuestion result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(body, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(room).To(Equal(expectedLikeQuestionResult))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while extracting user ID", func() {
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return("", errors.New("an error")).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnauthorized))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("a general error occurs while liking a question", func() {
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				userID := "6117e377b6e7bae09f52c483"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().LikeQuestion(userID, roomID, questionID, "").Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 500 Internal Server Error", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusInternalServerError))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the room has ended", func() {
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				userID := "6117e377b6e7bae09f52c483"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().LikeQuestion(userID, roomID, questionID, "").Return(entities.Room{}, domain.NewRoomEndedError("a sala já foi encerrada.")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 410 Gone", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusGone))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Desliking a question", func() {
+		var roomID string
+		var questionID string
+		var likeID string
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			route := strings.Replace(routes.DESLIKE_QUESTION_ROUTE, ":roomID", roomID, 1)
+			route = strings.Replace(route, ":questionID", questionID, 1)
+			route = strings.Replace(route, ":likeID", likeID, 1)
+
+			req := httptest.NewRequest(fiber.MethodDelete, route, nil)
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("deslike a question with success", func() {
+			var expectedDeslikeQuestionResult entities.Room
+
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedDeslikeQuestionResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				likeID = "6176903081f4f3f262acd6b4"
+				userID := "6117e377b6e7bae09f52c483"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().DeslikeQuestion(userID, roomID, questionID, likeID).Return(expectedDeslikeQuestionResult, nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
+			})
+
+			It("response body should be equal to roomService.DeslikeQuestion result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(body, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(room).To(Equal(expectedDeslikeQuestionResult))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while extracting user ID", func() {
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				likeID = "6176903081f4f3f262acd6b4"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return("", errors.New("an error")).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnauthorized))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("a general error occurs while desliking a question", func() {
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				likeID = "6176903081f4f3f262acd6b4"
+				userID := "6117e377b6e7bae09f52c483"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().DeslikeQuestion(userID, roomID, questionID, likeID).Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 500 Internal Server Error", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusInternalServerError))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Deleting a question", func() {
+		var roomID string
+		var questionID string
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			route := strings.Replace(routes.DELETE_QUESTION_ROUTE, ":roomID", roomID, 1)
+			route = strings.Replace(route, ":questionID", questionID, 1)
+
+			req := httptest.NewRequest(fiber.MethodDelete, route, nil)
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("deslike a question with success", func() {
+			var expectedDeleteQuestionResult entities.Room
+
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomSerialized, &expectedDeleteQuestionResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				userID := "621f5e02e07fdbb81c8221f5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().DeleteQuestion(userID, roomID, questionID).Return(expectedDeleteQuestionResult, nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
+			})
+
+			It("response body should be equal to roomService.DeslikeQuestion result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(body, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(room).To(Equal(expectedDeleteQuestionResult))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("an error occurs while extracting user ID", func() {
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return("", errors.New("an error")).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnauthorized))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("a general error occurs while deleting a question", func() {
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				userID := "621f5e02e07fdbb81c8221f5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().DeleteQuestion(userID, roomID, questionID).Return(entities.Room{}, errors.New("an error")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 500 Internal Server Error", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusInternalServerError))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+	})
+
+	Describe("Adding a member to a room", func() {
+		var roomID string
+		var input *bytes.Buffer
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			route := strings.Replace(routes.ADD_MEMBER_ROUTE, ":roomID", roomID, 1)
+
+			req := httptest.NewRequest(fiber.MethodPost, route, input)
+			req.Header.Set("Content-Type", "application/json")
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the member is added with success", func() {
+			var expectedAddMemberResult entities.Room
+
+			BeforeEach(func() {
+				addMemberRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/add_member_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomSerialized, err := ioutil.ReadFile("../../../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomSerialized, &expectedAddMemberResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				var memberDTO dtos.MemberDTO
+				err = json.Unmarshal(addMemberRequestSerialized, &memberDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID := "621f5e02e07fdbb81c8221f5"
+
+				input = bytes.NewBuffer(addMemberRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().AddMember(userID, roomID, memberDTO).Return(expectedAddMemberResult, nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
+			})
+
+			It("response body should be equal to roomService.AddMember result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(body, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(room).To(Equal(expectedAddMemberResult))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the role is not one that can be granted", func() {
+			BeforeEach(func() {
+				addMemberRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/add_member_request_invalid.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID := "621f5e02e07fdbb81c8221f5"
+
+				input = bytes.NewBuffer(addMemberRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 422 Unprocessable Entity", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnprocessableEntity))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user is not allowed to manage the members", func() {
+			BeforeEach(func() {
+				addMemberRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/add_member_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var memberDTO dtos.MemberDTO
+				err = json.Unmarshal(addMemberRequestSerialized, &memberDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID := "621f5f40e07fdbb81c8221f8"
+
+				input = bytes.NewBuffer(addMemberRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().AddMember(userID, roomID, memberDTO).Return(entities.Room{}, application.NewForbiddenError("você não tem permissão para gerenciar os membros desta sala.")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusForbidden))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Removing a member from a room", func() {
+		var roomID string
+		var memberID string
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			route := strings.Replace(routes.REMOVE_MEMBER_ROUTE, ":roomID", roomID, 1)
+			route = strings.Replace(route, ":memberID", memberID, 1)
+
+			req := httptest.NewRequest(fiber.MethodDelete, route, nil)
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the member is removed with success", func() {
+			var expectedRemoveMemberResult entities.Room
+
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../../../test/resources/room.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomSerialized, &expectedRemoveMemberResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				memberID = "6117e377b6e7bae09f52c483"
+				userID := "621f5e02e07fdbb81c8221f5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().RemoveMember(userID, roomID, memberID).Return(expectedRemoveMemberResult, nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
+			})
+
+			It("response body should be equal to roomService.RemoveMember result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(body, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(room).To(Equal(expectedRemoveMemberResult))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the member does not exist", func() {
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				memberID = "6117e377b6e7bae09f52c483"
+				userID := "621f5e02e07fdbb81c8221f5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().RemoveMember(userID, roomID, memberID).Return(entities.Room{}, domain.NewResourceNotFoundError("membro não encontrado.")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 404 Not Found", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusNotFound))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Updating the room settings", func() {
+		var roomID string
+		var input *bytes.Buffer
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			route := strings.Replace(routes.UPDATE_ROOM_SETTINGS_ROUTE, ":roomID", roomID, 1)
+
+			req := httptest.NewRequest(fiber.MethodPatch, route, input)
+			req.Header.Set("Content-Type", "application/json")
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the settings are updated with success", func() {
+			var expectedUpdateSettingsResult entities.Room
+
+			BeforeEach(func() {
+				updateRoomSettingsRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/update_room_settings_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_anonymous_question.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomSerialized, &expectedUpdateSettingsResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				var settingsDTO dtos.RoomSettingsDTO
+				err = json.Unmarshal(updateRoomSettingsRequestSerialized, &settingsDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID := "621f5e02e07fdbb81c8221f5"
+
+				input = bytes.NewBuffer(updateRoomSettingsRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().UpdateSettings(userID, roomID, settingsDTO).Return(expectedUpdateSettingsResult, nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
+			})
+
+			It("response body should be equal to roomService.UpdateSettings result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(body, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(room).To(Equal(expectedUpdateSettingsResult))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the request body is incomplete", func() {
+			BeforeEach(func() {
+				updateRoomSettingsRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/update_room_settings_request_incomplete.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID := "621f5e02e07fdbb81c8221f5"
+
+				input = bytes.NewBuffer(updateRoomSettingsRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 422 Unprocessable Entity", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnprocessableEntity))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Listing the questions waiting for moderation", func() {
+		var roomID string
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			route := strings.Replace(routes.FIND_PENDING_QUESTIONS_ROUTE, ":roomID", roomID, 1)
+
+			req := httptest.NewRequest(fiber.MethodGet, route, nil)
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the moderation queue is listed with success", func() {
+			var expectedFindPendingQuestionsResult dtos.QuestionPageDTO
+
+			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_pending_question.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(roomSerialized, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID := "621f5e02e07fdbb81c8221f5"
+
+				expectedFindPendingQuestionsResult = dtos.QuestionPageDTO{Questions: room.Questions}
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().FindPendingQuestions(userID, roomID, dtos.QuestionQueryDTO{}).Return(expectedFindPendingQuestionsResult, nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
+			})
+
+			It("response body should be equal to roomService.FindPendingQuestions result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var page dtos.QuestionPageDTO
+				err = json.Unmarshal(body, &page)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(page).To(Equal(expectedFindPendingQuestionsResult))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user does not moderate the room", func() {
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID := "6117e377b6e7bae09f52c483"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().FindPendingQuestions(userID, roomID, dtos.QuestionQueryDTO{}).Return(dtos.QuestionPageDTO{}, application.NewForbiddenError("você não tem permissão para moderar as perguntas desta sala.")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusForbidden))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Approving a question", func() {
+		var roomID string
+		var questionID string
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			route := strings.Replace(routes.APPROVE_QUESTION_ROUTE, ":roomID", roomID, 1)
+			route = strings.Replace(route, ":questionID", questionID, 1)
+
+			req := httptest.NewRequest(fiber.MethodPost, route, nil)
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the question is approved with success", func() {
+			var expectedApproveQuestionResult entities.Room
+
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedApproveQuestionResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				userID := "621f5e02e07fdbb81c8221f5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().ApproveQuestion(userID, roomID, questionID).Return(expectedApproveQuestionResult, nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
+			})
+
+			It("response body should be equal to roomService.ApproveQuestion result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(body, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(room).To(Equal(expectedApproveQuestionResult))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Rejecting a question", func() {
+		var roomID string
+		var questionID string
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
 			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			route := strings.Replace(routes.REJECT_QUESTION_ROUTE, ":roomID", roomID, 1)
+			route = strings.Replace(route, ":questionID", questionID, 1)
+
+			req := httptest.NewRequest(fiber.MethodPost, route, nil)
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
 		})
 
-		When("an error occurs while extracting user ID", func() {
+		When("the question is not waiting for moderation", func() {
 			BeforeEach(func() {
-				markQuestionAsAnsweredRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/mark_question_as_answered_request.json")
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				userID := "621f5e02e07fdbb81c8221f5"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().RejectQuestion(userID, roomID, questionID).Return(entities.Room{}, domain.NewConflictError("a pergunta não está aguardando moderação.")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 409 Conflict", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusConflict))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Creating a reply", func() {
+		var roomID string
+		var questionID string
+		var input *bytes.Buffer
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			route := strings.Replace(routes.CREATE_REPLY_ROUTE, ":roomID", roomID, 1)
+			route = strings.Replace(route, ":questionID", questionID, 1)
+
+			req := httptest.NewRequest(fiber.MethodPost, route, input)
+			req.Header.Set("Content-Type", "application/json")
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the reply is created with success", func() {
+			var expectedCreateReplyResult entities.Room
+
+			BeforeEach(func() {
+				createReplyRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/create_reply_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionRepliedSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_question_replied.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionRepliedSerialized, &expectedCreateReplyResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				var replyDTO dtos.ReplyDTO
+				err = json.Unmarshal(createReplyRequestSerialized, &replyDTO)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				userID := "621f5f40e07fdbb81c8221f8"
+
+				input = bytes.NewBuffer(createReplyRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().CreateReply(userID, roomID, questionID, replyDTO, "").Return(expectedCreateReplyResult, nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 201 Created", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusCreated))
+			})
+
+			It("response body should be equal to roomService.CreateReply result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(body, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(room).To(Equal(expectedCreateReplyResult))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the request body is incomplete", func() {
+			BeforeEach(func() {
+				createReplyRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/create_reply_request_incomplete.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				userID := "621f5f40e07fdbb81c8221f8"
+
+				input = bytes.NewBuffer(createReplyRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 422 Unprocessable Entity", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnprocessableEntity))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Updating a reply", func() {
+		var roomID string
+		var questionID string
+		var replyID string
+		var input *bytes.Buffer
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			route := strings.Replace(routes.UPDATE_REPLY_ROUTE, ":roomID", roomID, 1)
+			route = strings.Replace(route, ":questionID", questionID, 1)
+			route = strings.Replace(route, ":replyID", replyID, 1)
+
+			req := httptest.NewRequest(fiber.MethodPatch, route, input)
+			req.Header.Set("Content-Type", "application/json")
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the reply is updated with success", func() {
+			var expectedUpdateReplyResult entities.Room
+
+			BeforeEach(func() {
+				updateReplyRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/update_reply_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithQuestionRepliedSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_question_replied.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionRepliedSerialized, &expectedUpdateReplyResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				var replyData dtos.UpdateReplyDTO
+				err = json.Unmarshal(updateReplyRequestSerialized, &replyData)
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				replyID = "6220a402e07fdbb81c8221fb"
+				userID := "621f5f40e07fdbb81c8221f8"
+
+				input = bytes.NewBuffer(updateReplyRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().UpdateReply(userID, roomID, questionID, replyID, replyData).Return(expectedUpdateReplyResult, nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
+			})
+
+			It("response body should be equal to roomService.UpdateReply result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(body, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(room).To(Equal(expectedUpdateReplyResult))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the request body is incomplete", func() {
+			BeforeEach(func() {
+				updateReplyRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/update_reply_request_incomplete.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				questionID = "621f5f94e07fdbb81c8221f9"
+				replyID = "6220a402e07fdbb81c8221fb"
+				userID := "621f5f40e07fdbb81c8221f8"
+
+				input = bytes.NewBuffer(updateReplyRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 422 Unprocessable Entity", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnprocessableEntity))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Deleting a reply", func() {
+		var roomID string
+		var questionID string
+		var replyID string
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			route := strings.Replace(routes.DELETE_REPLY_ROUTE, ":roomID", roomID, 1)
+			route = strings.Replace(route, ":questionID", questionID, 1)
+			route = strings.Replace(route, ":replyID", replyID, 1)
+
+			req := httptest.NewRequest(fiber.MethodDelete, route, nil)
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the reply is deleted with success", func() {
+			var expectedDeleteReplyResult entities.Room
+
+			BeforeEach(func() {
+				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedDeleteReplyResult)
 				Expect(err).NotTo(HaveOccurred())
 
 				roomID = "621f5ec1e07fdbb81c8221f7"
 				questionID = "621f5f94e07fdbb81c8221f9"
-
-				input = bytes.NewBuffer(markQuestionAsAnsweredRequestSerialized)
+				replyID = "6220a402e07fdbb81c8221fb"
+				userID := "621f5f40e07fdbb81c8221f8"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
-				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return("", errors.New("an error")).Times(1)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().DeleteReply(userID, roomID, questionID, replyID).Return(expectedDeleteReplyResult, nil).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
-			It("response status code should be equal to 401 Unauthorized", func() {
-				Expect(response.StatusCode).To(Equal(fiber.StatusUnauthorized))
+			It("response status code should be equal to 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
+			})
+
+			It("response body should be equal to roomService.DeleteReply result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(body, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(room).To(Equal(expectedDeleteReplyResult))
 			})
 
 			AfterEach(func() {
@@ -793,36 +3220,30 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("a general error occurs while updating question", func() {
+		When("the reply belongs to someone else", func() {
 			BeforeEach(func() {
-				highlightQuestionRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/highlight_question_request.json")
-				Expect(err).NotTo(HaveOccurred())
-
-				var questionData dtos.UpdateQuestionDTO
-				err = json.Unmarshal(highlightQuestionRequestSerialized, &questionData)
-				Expect(err).NotTo(HaveOccurred())
-
 				roomID = "621f5ec1e07fdbb81c8221f7"
 				questionID = "621f5f94e07fdbb81c8221f9"
-				userID := "621f5e02e07fdbb81c8221f5"
-
-				input = bytes.NewBuffer(highlightQuestionRequestSerialized)
+				replyID = "6220a3d1e07fdbb81c8221fa"
+				userID := "621f5f40e07fdbb81c8221f8"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
 
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
-				mockRoomService.EXPECT().UpdateQuestion(userID, roomID, questionID, questionData).Return(entities.Room{}, errors.New("an error")).Times(1)
+				mockRoomService.EXPECT().DeleteReply(userID, roomID, questionID, replyID).Return(entities.Room{}, application.NewForbiddenError("você não pode remover uma resposta que não é sua.")).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
-			It("response status code should be equal to 500 Internal Server Error", func() {
-				Expect(response.StatusCode).To(Equal(fiber.StatusInternalServerError))
+			It("response status code should be equal to 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusForbidden))
 			})
 
 			AfterEach(func() {
@@ -831,9 +3252,8 @@ var _ = Describe("Room", func() {
 		})
 	})
 
-	Describe("Liking a question", func() {
+	Describe("Creating a poll", func() {
 		var roomID string
-		var questionID string
 		var input *bytes.Buffer
 		var response *http.Response
 		var mockCtrl *gomock.Controller
@@ -846,10 +3266,9 @@ var _ = Describe("Room", func() {
 				ErrorHandler: infrastructure.Handler,
 			})
 
-			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
 
-			route := strings.Replace(routes.LIKE_QUESTION_ROUTE, ":roomID", roomID, 1)
-			route = strings.Replace(route, ":questionID", questionID, 1)
+			route := strings.Replace(routes.CREATE_POLL_ROUTE, ":roomID", roomID, 1)
 
 			req := httptest.NewRequest(fiber.MethodPost, route, input)
 			req.Header.Set("Content-Type", "application/json")
@@ -858,45 +3277,48 @@ var _ = Describe("Room", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
-		When("like a question with success", func() {
-			var expectedLikeQuestionResult entities.Room
+		When("the poll is created with success", func() {
+			var expectedCreatePollResult entities.Room
 
 			BeforeEach(func() {
-				likeQuestionRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/like_question_request.json")
+				createPollRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/create_poll_request.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				roomWithQuestionLikedSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_question_liked.json")
+				roomWithPollSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_poll.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				err = json.Unmarshal(roomWithQuestionLikedSerialized, &expectedLikeQuestionResult)
+				err = json.Unmarshal(roomWithPollSerialized, &expectedCreatePollResult)
 				Expect(err).NotTo(HaveOccurred())
 
-				var like entities.Like
-				err = json.Unmarshal(likeQuestionRequestSerialized, &like)
+				var pollDTO dtos.PollDTO
+				err = json.Unmarshal(createPollRequestSerialized, &pollDTO)
 				Expect(err).NotTo(HaveOccurred())
 
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c8221f9"
+				userID := "621f5e02e07fdbb81c8221f5"
 
-				input = bytes.NewBuffer(likeQuestionRequestSerialized)
+				input = bytes.NewBuffer(createPollRequestSerialized)
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
-				mockRoomService.EXPECT().LikeQuestion(roomID, questionID, like).Return(expectedLikeQuestionResult, nil).Times(1)
+				mockRoomService.EXPECT().CreatePoll(userID, roomID, pollDTO).Return(expectedCreatePollResult, nil).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
-			It("response status code should be equal to 200 OK", func() {
-				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
+			It("response status code should be equal to 201 Created", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusCreated))
 			})
 
-			It("response body should be equal to roomService.LikeQuestion result", func() {
+			It("response body should be equal to roomService.CreatePoll result", func() {
 				body, err := ioutil.ReadAll(response.Body)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -904,7 +3326,7 @@ var _ = Describe("Room", func() {
 				err = json.Unmarshal(body, &room)
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(room).To(Equal(expectedLikeQuestionResult))
+				Expect(room).To(Equal(expectedCreatePollResult))
 			})
 
 			AfterEach(func() {
@@ -912,25 +3334,28 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("validation fails while liking a question", func() {
+		When("the poll has less than two options", func() {
 			BeforeEach(func() {
-				likeQuestionRequestIncompleteSerialized, err := ioutil.ReadFile("../../../../../test/resources/like_question_request_incomplete.json")
+				createPollRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/create_poll_request_incomplete.json")
 				Expect(err).NotTo(HaveOccurred())
 
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c8221f9"
+				userID := "621f5e02e07fdbb81c8221f5"
 
-				input = bytes.NewBuffer(likeQuestionRequestIncompleteSerialized)
+				input = bytes.NewBuffer(createPollRequestSerialized)
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
 			It("response status code should be equal to 422 Unprocessable Entity", func() {
@@ -941,27 +3366,86 @@ var _ = Describe("Room", func() {
 				mockCtrl.Finish()
 			})
 		})
+	})
+
+	Describe("Voting on a poll", func() {
+		var roomID string
+		var pollID string
+		var input *bytes.Buffer
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			route := strings.Replace(routes.VOTE_POLL_ROUTE, ":roomID", roomID, 1)
+			route = strings.Replace(route, ":pollID", pollID, 1)
+
+			req := httptest.NewRequest(fiber.MethodPost, route, input)
+			req.Header.Set("Content-Type", "application/json")
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the vote is registered with success", func() {
+			var expectedVoteResult entities.Room
 
-		When("request body comes with an invalid payload", func() {
 			BeforeEach(func() {
+				voteRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/vote_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomWithPollSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_poll.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(roomWithPollSerialized, &expectedVoteResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				var voteDTO dtos.VoteDTO
+				err = json.Unmarshal(voteRequestSerialized, &voteDTO)
+				Expect(err).NotTo(HaveOccurred())
+
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c8221f9"
+				pollID = "6221b7c4e07fdbb81c8221fd"
+				userID := "621f5f40e07fdbb81c8221f8"
 
-				input = bytes.NewBuffer(nil)
+				input = bytes.NewBuffer(voteRequestSerialized)
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().Vote(userID, roomID, pollID, voteDTO, "").Return(expectedVoteResult, nil).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
-			It("response status code should be equal to 400 Bad Request", func() {
-				Expect(response.StatusCode).To(Equal(fiber.StatusBadRequest))
+			It("response status code should be equal to 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
+			})
+
+			It("response body should be equal to roomService.Vote result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(body, &room)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(room).To(Equal(expectedVoteResult))
 			})
 
 			AfterEach(func() {
@@ -969,34 +3453,72 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("a general error occurs while liking a question", func() {
+		When("no option is chosen", func() {
+			BeforeEach(func() {
+				voteRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/vote_request_incomplete.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				pollID = "6221b7c4e07fdbb81c8221fd"
+				userID := "621f5f40e07fdbb81c8221f8"
+
+				input = bytes.NewBuffer(voteRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 422 Unprocessable Entity", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnprocessableEntity))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user has already voted", func() {
 			BeforeEach(func() {
-				likeQuestionRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/like_question_request.json")
+				voteRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/vote_request.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				var like entities.Like
-				err = json.Unmarshal(likeQuestionRequestSerialized, &like)
+				var voteDTO dtos.VoteDTO
+				err = json.Unmarshal(voteRequestSerialized, &voteDTO)
 				Expect(err).NotTo(HaveOccurred())
 
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c8221f9"
+				pollID = "6221b7c4e07fdbb81c8221fd"
+				userID := "621f5f40e07fdbb81c8221f8"
 
-				input = bytes.NewBuffer(likeQuestionRequestSerialized)
+				input = bytes.NewBuffer(voteRequestSerialized)
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
-				mockRoomService.EXPECT().LikeQuestion(roomID, questionID, like).Return(entities.Room{}, errors.New("an error")).Times(1)
+				mockRoomService.EXPECT().Vote(userID, roomID, pollID, voteDTO, "").Return(entities.Room{}, domain.NewConflictError("você já votou nesta enquete.")).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
-			It("response status code should be equal to 500 Internal Server Error", func() {
-				Expect(response.StatusCode).To(Equal(fiber.StatusInternalServerError))
+			It("response status code should be equal to 409 Conflict", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusConflict))
 			})
 
 			AfterEach(func() {
@@ -1005,10 +3527,10 @@ var _ = Describe("Room", func() {
 		})
 	})
 
-	Describe("Desliking a question", func() {
+	Describe("Editing a question", func() {
 		var roomID string
 		var questionID string
-		var likeID string
+		var input *bytes.Buffer
 		var response *http.Response
 		var mockCtrl *gomock.Controller
 		var roomController *controllers.RoomController
@@ -1020,49 +3542,61 @@ var _ = Describe("Room", func() {
 				ErrorHandler: infrastructure.Handler,
 			})
 
-			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
 
-			route := strings.Replace(routes.DESLIKE_QUESTION_ROUTE, ":roomID", roomID, 1)
+			route := strings.Replace(routes.EDIT_QUESTION_ROUTE, ":roomID", roomID, 1)
 			route = strings.Replace(route, ":questionID", questionID, 1)
-			route = strings.Replace(route, ":likeID", likeID, 1)
 
-			req := httptest.NewRequest(fiber.MethodDelete, route, nil)
+			req := httptest.NewRequest(fiber.MethodPut, route, input)
+			req.Header.Set("Content-Type", "application/json")
 
 			response, err = app.Test(req)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
-		When("deslike a question with success", func() {
-			var expectedDeslikeQuestionResult entities.Room
+		When("the question is edited with success", func() {
+			var expectedEditQuestionResult entities.Room
 
 			BeforeEach(func() {
+				editQuestionRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/edit_question_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
 				roomWithQuestionsSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_questions.json")
 				Expect(err).NotTo(HaveOccurred())
 
-				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedDeslikeQuestionResult)
+				err = json.Unmarshal(roomWithQuestionsSerialized, &expectedEditQuestionResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				var questionDTO dtos.EditQuestionDTO
+				err = json.Unmarshal(editQuestionRequestSerialized, &questionDTO)
 				Expect(err).NotTo(HaveOccurred())
 
 				roomID = "621f5ec1e07fdbb81c8221f7"
 				questionID = "621f5f94e07fdbb81c8221f9"
-				likeID = "6176903081f4f3f262acd6b4"
+				userID := "621f5f40e07fdbb81c8221f8"
+
+				input = bytes.NewBuffer(editQuestionRequestSerialized)
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
-				mockRoomService.EXPECT().DeslikeQuestion(roomID, questionID, likeID).Return(expectedDeslikeQuestionResult, nil).Times(1)
+				mockRoomService.EXPECT().EditQuestion(userID, roomID, questionID, questionDTO).Return(expectedEditQuestionResult, nil).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
 			It("response status code should be equal to 200 OK", func() {
 				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
 			})
 
-			It("response body should be equal to roomService.DeslikeQuestion result", func() {
+			It("response body should be equal to roomService.EditQuestion result", func() {
 				body, err := ioutil.ReadAll(response.Body)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -1070,7 +3604,7 @@ var _ = Describe("Room", func() {
 				err = json.Unmarshal(body, &room)
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(room).To(Equal(expectedDeslikeQuestionResult))
+				Expect(room).To(Equal(expectedEditQuestionResult))
 			})
 
 			AfterEach(func() {
@@ -1078,26 +3612,33 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("a general error occurs while desliking a question", func() {
+		When("the request body is incomplete", func() {
 			BeforeEach(func() {
+				editQuestionRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/edit_question_request_incomplete.json")
+				Expect(err).NotTo(HaveOccurred())
+
 				roomID = "621f5ec1e07fdbb81c8221f7"
 				questionID = "621f5f94e07fdbb81c8221f9"
-				likeID = "6176903081f4f3f262acd6b4"
+				userID := "621f5f40e07fdbb81c8221f8"
+
+				input = bytes.NewBuffer(editQuestionRequestSerialized)
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
-				mockRoomService.EXPECT().DeslikeQuestion(roomID, questionID, likeID).Return(entities.Room{}, errors.New("an error")).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
-			It("response status code should be equal to 500 Internal Server Error", func() {
-				Expect(response.StatusCode).To(Equal(fiber.StatusInternalServerError))
+			It("response status code should be equal to 422 Unprocessable Entity", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnprocessableEntity))
 			})
 
 			AfterEach(func() {
@@ -1106,7 +3647,7 @@ var _ = Describe("Room", func() {
 		})
 	})
 
-	Describe("Deleting a question", func() {
+	Describe("Listing the edit history of a question", func() {
 		var roomID string
 		var questionID string
 		var response *http.Response
@@ -1120,26 +3661,24 @@ var _ = Describe("Room", func() {
 				ErrorHandler: infrastructure.Handler,
 			})
 
-			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
 
-			route := strings.Replace(routes.DELETE_QUESTION_ROUTE, ":roomID", roomID, 1)
+			route := strings.Replace(routes.FIND_QUESTION_REVISIONS_ROUTE, ":roomID", roomID, 1)
 			route = strings.Replace(route, ":questionID", questionID, 1)
 
-			req := httptest.NewRequest(fiber.MethodDelete, route, nil)
+			req := httptest.NewRequest(fiber.MethodGet, route, nil)
 
 			response, err = app.Test(req)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
-		When("deslike a question with success", func() {
-			var expectedDeleteQuestionResult entities.Room
+		When("the edit history is listed with success", func() {
+			var expectedRevisions []entities.QuestionRevision
 
 			BeforeEach(func() {
-				roomSerialized, err := ioutil.ReadFile("../../../../../test/resources/room.json")
-				Expect(err).NotTo(HaveOccurred())
-
-				err = json.Unmarshal(roomSerialized, &expectedDeleteQuestionResult)
-				Expect(err).NotTo(HaveOccurred())
+				expectedRevisions = []entities.QuestionRevision{
+					{Content: "O Nokia N95 foi o melhor celular?", CreatedAt: time.Date(2022, 3, 2, 12, 14, 12, 0, time.UTC)},
+				}
 
 				roomID = "621f5ec1e07fdbb81c8221f7"
 				questionID = "621f5f94e07fdbb81c8221f9"
@@ -1150,27 +3689,29 @@ var _ = Describe("Room", func() {
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
 
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
-				mockRoomService.EXPECT().DeleteQuestion(userID, roomID, questionID).Return(expectedDeleteQuestionResult, nil).Times(1)
+				mockRoomService.EXPECT().FindQuestionRevisions(userID, roomID, questionID).Return(expectedRevisions, nil).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
 			It("response status code should be equal to 200 OK", func() {
 				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
 			})
 
-			It("response body should be equal to roomService.DeslikeQuestion result", func() {
+			It("response body should be equal to roomService.FindQuestionRevisions result", func() {
 				body, err := ioutil.ReadAll(response.Body)
 				Expect(err).NotTo(HaveOccurred())
 
-				var room entities.Room
-				err = json.Unmarshal(body, &room)
+				var revisions []entities.QuestionRevision
+				err = json.Unmarshal(body, &revisions)
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(room).To(Equal(expectedDeleteQuestionResult))
+				Expect(revisions).To(Equal(expectedRevisions))
 			})
 
 			AfterEach(func() {
@@ -1178,53 +3719,104 @@ var _ = Describe("Room", func() {
 			})
 		})
 
-		When("an error occurs while extracting user ID", func() {
+		When("the user is not allowed to see the edit history", func() {
 			BeforeEach(func() {
 				roomID = "621f5ec1e07fdbb81c8221f7"
 				questionID = "621f5f94e07fdbb81c8221f9"
+				userID := "621f5f40e07fdbb81c8221f8"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
-				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return("", errors.New("an error")).Times(1)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
 
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().FindQuestionRevisions(userID, roomID, questionID).Return(nil, application.NewForbiddenError("você não tem permissão para ver o histórico de edições das perguntas desta sala.")).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
-			It("response status code should be equal to 401 Unauthorized", func() {
-				Expect(response.StatusCode).To(Equal(fiber.StatusUnauthorized))
+			It("response status code should be equal to 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusForbidden))
 			})
 
 			AfterEach(func() {
 				mockCtrl.Finish()
 			})
 		})
+	})
+
+	Describe("Listing the deleted questions", func() {
+		var roomID string
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var roomController *controllers.RoomController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupRoomRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, func(c *fiber.Ctx) error { return c.Next() }, roomController)
+
+			route := strings.Replace(routes.FIND_DELETED_QUESTIONS_ROUTE, ":roomID", roomID, 1)
+
+			req := httptest.NewRequest(fiber.MethodGet, route, nil)
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the deleted questions are listed with success", func() {
+			var expectedFindDeletedQuestionsResult dtos.QuestionPageDTO
 
-		When("a general error occurs while deleting a question", func() {
 			BeforeEach(func() {
+				roomSerialized, err := ioutil.ReadFile("../../../../../test/resources/room_with_questions.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var room entities.Room
+				err = json.Unmarshal(roomSerialized, &room)
+				Expect(err).NotTo(HaveOccurred())
+
 				roomID = "621f5ec1e07fdbb81c8221f7"
-				questionID = "621f5f94e07fdbb81c8221f9"
 				userID := "621f5e02e07fdbb81c8221f5"
 
+				expectedFindDeletedQuestionsResult = dtos.QuestionPageDTO{Questions: room.Questions}
+
 				mockCtrl = gomock.NewController(GinkgoT())
 
 				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
 				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
 
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
 				mockRoomService := mocks.NewMockRoomService(mockCtrl)
-				mockRoomService.EXPECT().DeleteQuestion(userID, roomID, questionID).Return(entities.Room{}, errors.New("an error")).Times(1)
+				mockRoomService.EXPECT().FindDeletedQuestions(userID, roomID, dtos.QuestionQueryDTO{}).Return(expectedFindDeletedQuestionsResult, nil).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, validationProvider)
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
 			})
 
-			It("response status code should be equal to 500 Internal Server Error", func() {
-				Expect(response.StatusCode).To(Equal(fiber.StatusInternalServerError))
+			It("response status code should be equal to 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
+			})
+
+			It("response body should be equal to roomService.FindDeletedQuestions result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var page dtos.QuestionPageDTO
+				err = json.Unmarshal(body, &page)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(page).To(Equal(expectedFindDeletedQuestionsResult))
 			})
 
 			AfterEach(func() {
@@ -1232,5 +3824,33 @@ var _ = Describe("Room", func() {
 			})
 		})
 
+		When("the user cannot audit the room", func() {
+			BeforeEach(func() {
+				roomID = "621f5ec1e07fdbb81c8221f7"
+				userID := "6117e377b6e7bae09f52c483"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockBroadcaster := broadcastingMocks.NewMockBroadcaster(mockCtrl)
+
+				mockRoomService := mocks.NewMockRoomService(mockCtrl)
+				mockRoomService.EXPECT().FindDeletedQuestions(userID, roomID, dtos.QuestionQueryDTO{}).Return(dtos.QuestionPageDTO{}, application.NewForbiddenError("você não tem permissão para ver as perguntas removidas desta sala.")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				roomController = controllers.NewRoomController(mockRoomService, mockAuthenticator, mockBroadcaster, validationProvider)
+			})
+
+			It("response status code should be equal to 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusForbidden))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
 	})
 })
@@ -9,13 +9,15 @@ import (
 )
 
 type UserController struct {
-	userService services.UserService
-	validator   providers.Validator
+	userService   services.UserService
+	authenticator providers.Authenticator
+	validator     providers.Validator
 }
 
-func NewUserController(userService services.UserService, validationProvider providers.Validator) *UserController {
+func NewUserController(userService services.UserService, authProvider providers.Authenticator, validationProvider providers.Validator) *UserController {
 	return &UserController{
 		userService,
+		authProvider,
 		validationProvider,
 	}
 }
@@ -50,6 +52,40 @@ func (controller *UserController) Create(ctx *fiber.Ctx) error {
 	return ctx.Status(fiber.StatusCreated).JSON(user)
 }
 
+func (controller *UserController) VerifyEmail(ctx *fiber.Ctx) error {
+	var verification dtos.VerifyEmailDTO
+
+	err := ctx.QueryParser(&verification)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	errors := controller.validator.ValidateStruct(verification)
+	if errors != nil {
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(errors)
+	}
+
+	user, err := controller.userService.VerifyEmail(verification.Token)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(user)
+}
+
+func (controller *UserController) ResendVerification(ctx *fiber.Ctx) error {
+	userID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	if err := controller.userService.ResendVerification(userID); err != nil {
+		return err
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
 func (controller *UserController) FindByID(ctx *fiber.Ctx) error {
 	userID := ctx.Params("userID")
 
@@ -62,9 +98,14 @@ func (controller *UserController) FindByID(ctx *fiber.Ctx) error {
 }
 
 func (controller *UserController) Update(ctx *fiber.Ctx) error {
+	actingUserID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
 	var user dtos.UserDTO
 
-	err := ctx.BodyParser(&user)
+	err = ctx.BodyParser(&user)
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
@@ -76,7 +117,7 @@ func (controller *UserController) Update(ctx *fiber.Ctx) error {
 
 	userID := ctx.Params("userID")
 
-	updatedUser, err := controller.userService.Update(userID, user)
+	updatedUser, err := controller.userService.Update(actingUserID, userID, user)
 	if err != nil {
 		return err
 	}
@@ -87,7 +128,12 @@ func (controller *UserController) Update(ctx *fiber.Ctx) error {
 func (controller *UserController) Delete(ctx *fiber.Ctx) error {
 	userID := ctx.Params("userID")
 
-	err := controller.userService.Delete(userID)
+	actingUserID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
+	err = controller.userService.Delete(actingUserID, userID)
 	if err != nil {
 		return err
 	}
@@ -96,9 +142,14 @@ func (controller *UserController) Delete(ctx *fiber.Ctx) error {
 }
 
 func (controller *UserController) UpdatePassword(ctx *fiber.Ctx) error {
+	actingUserID, err := controller.authenticator.ExtractUserID(ctx.Locals("user"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+
 	var password dtos.PasswordDTO
 
-	err := ctx.BodyParser(&password)
+	err = ctx.BodyParser(&password)
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
@@ -110,7 +161,7 @@ func (controller *UserController) UpdatePassword(ctx *fiber.Ctx) error {
 
 	userID := ctx.Params("userID")
 
-	if err := controller.userService.UpdatePassword(userID, password); err != nil {
+	if err := controller.userService.UpdatePassword(actingUserID, userID, password); err != nil {
 		return err
 	}
 
@@ -15,9 +15,11 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/waliqueiroz/letmeask-api/internal/application/dtos"
+	application "github.com/waliqueiroz/letmeask-api/internal/application/errors"
 	"github.com/waliqueiroz/letmeask-api/internal/application/services/mocks"
 	"github.com/waliqueiroz/letmeask-api/internal/domain/entities"
 	domain "github.com/waliqueiroz/letmeask-api/internal/domain/errors"
+	authMocks "github.com/waliqueiroz/letmeask-api/internal/infrastructure/authentication/mocks"
 	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/http/fiber/controllers"
 	infrastructure "github.com/waliqueiroz/letmeask-api/internal/infrastructure/http/fiber/errors"
 	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/http/fiber/routes"
@@ -58,12 +60,14 @@ var _ = Describe("User", func() {
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
 				mockUserService := mocks.NewMockUserService(mockCtrl)
 				mockUserService.EXPECT().FindAll().Return(expectedFindAllResult, nil).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				userController = controllers.NewUserController(mockUserService, validationProvider)
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 200 OK", func() {
@@ -90,12 +94,14 @@ var _ = Describe("User", func() {
 			BeforeEach(func() {
 				mockCtrl = gomock.NewController(GinkgoT())
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
 				mockUserService := mocks.NewMockUserService(mockCtrl)
 				mockUserService.EXPECT().FindAll().Return([]entities.User{}, errors.New("an error")).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				userController = controllers.NewUserController(mockUserService, validationProvider)
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 500 Internal Server Error", func() {
@@ -151,12 +157,14 @@ var _ = Describe("User", func() {
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
 				mockUserService := mocks.NewMockUserService(mockCtrl)
 				mockUserService.EXPECT().Create(user).Return(expectedCreateResult, nil).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				userController = controllers.NewUserController(mockUserService, validationProvider)
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 201 Created", func() {
@@ -188,11 +196,13 @@ var _ = Describe("User", func() {
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
 				mockUserService := mocks.NewMockUserService(mockCtrl)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				userController = controllers.NewUserController(mockUserService, validationProvider)
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 422 Unprocessable Entity", func() {
@@ -210,11 +220,13 @@ var _ = Describe("User", func() {
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
 				mockUserService := mocks.NewMockUserService(mockCtrl)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				userController = controllers.NewUserController(mockUserService, validationProvider)
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 400 Bad Request", func() {
@@ -239,12 +251,14 @@ var _ = Describe("User", func() {
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
 				mockUserService := mocks.NewMockUserService(mockCtrl)
 				mockUserService.EXPECT().Create(user).Return(entities.User{}, errors.New("an error")).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				userController = controllers.NewUserController(mockUserService, validationProvider)
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 500 Internal Server Error", func() {
@@ -294,13 +308,15 @@ var _ = Describe("User", func() {
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
 				mockUserService := mocks.NewMockUserService(mockCtrl)
 
 				mockUserService.EXPECT().FindByID(userID).Return(expectedFindByIDResult, nil).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				userController = controllers.NewUserController(mockUserService, validationProvider)
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 200 OK", func() {
@@ -329,13 +345,15 @@ var _ = Describe("User", func() {
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
 				mockUserService := mocks.NewMockUserService(mockCtrl)
 
 				mockUserService.EXPECT().FindByID(userID).Return(entities.User{}, domain.NewResourceNotFoundError()).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				userController = controllers.NewUserController(mockUserService, validationProvider)
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 404 Not Found", func() {
@@ -353,13 +371,15 @@ var _ = Describe("User", func() {
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
 				mockUserService := mocks.NewMockUserService(mockCtrl)
 
 				mockUserService.EXPECT().FindByID(userID).Return(entities.User{}, errors.New("an error")).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				userController = controllers.NewUserController(mockUserService, validationProvider)
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 500 Internal Server Error", func() {
@@ -419,13 +439,16 @@ var _ = Describe("User", func() {
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
 				mockUserService := mocks.NewMockUserService(mockCtrl)
 
-				mockUserService.EXPECT().Update(userID, updateUserRequest).Return(expectedUpdateResult, nil).Times(1)
+				mockUserService.EXPECT().Update(userID, userID, updateUserRequest).Return(expectedUpdateResult, nil).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				userController = controllers.NewUserController(mockUserService, validationProvider)
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 200 OK", func() {
@@ -462,11 +485,14 @@ var _ = Describe("User", func() {
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
 				mockUserService := mocks.NewMockUserService(mockCtrl)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				userController = controllers.NewUserController(mockUserService, validationProvider)
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 422 Unprocessable Entity", func() {
@@ -485,11 +511,14 @@ var _ = Describe("User", func() {
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
 				mockUserService := mocks.NewMockUserService(mockCtrl)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				userController = controllers.NewUserController(mockUserService, validationProvider)
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 400 Bad Request", func() {
@@ -501,6 +530,71 @@ var _ = Describe("User", func() {
 			})
 		})
 
+		When("an error occurs while extracting user ID", func() {
+			BeforeEach(func() {
+				updateUserRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/update_user_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				input = bytes.NewBuffer(updateUserRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return("", errors.New("an error")).Times(1)
+
+				mockUserService := mocks.NewMockUserService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnauthorized))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user is not allowed to update the user", func() {
+			BeforeEach(func() {
+				updateUserRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/update_user_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var updateUserRequest dtos.UserDTO
+				err = json.Unmarshal(updateUserRequestSerialized, &updateUserRequest)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				input = bytes.NewBuffer(updateUserRequestSerialized)
+				actingUserID := "621f5f40e07fdbb81c8221f8"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(actingUserID, nil).Times(1)
+
+				mockUserService := mocks.NewMockUserService(mockCtrl)
+
+				mockUserService.EXPECT().Update(actingUserID, userID, updateUserRequest).Return(entities.User{}, application.NewForbiddenError()).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusForbidden))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
 		When("an error occurs while updating user", func() {
 			BeforeEach(func() {
 				updateUserRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/update_user_request.json")
@@ -515,13 +609,16 @@ var _ = Describe("User", func() {
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
 				mockUserService := mocks.NewMockUserService(mockCtrl)
 
-				mockUserService.EXPECT().Update(userID, updateUserRequest).Return(entities.User{}, errors.New("an error")).Times(1)
+				mockUserService.EXPECT().Update(userID, userID, updateUserRequest).Return(entities.User{}, errors.New("an error")).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				userController = controllers.NewUserController(mockUserService, validationProvider)
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 500 Internal Server Error", func() {
@@ -562,13 +659,16 @@ var _ = Describe("User", func() {
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
 				mockUserService := mocks.NewMockUserService(mockCtrl)
 
-				mockUserService.EXPECT().Delete(userID).Return(nil).Times(1)
+				mockUserService.EXPECT().Delete(userID, userID).Return(nil).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				userController = controllers.NewUserController(mockUserService, validationProvider)
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 200 OK", func() {
@@ -580,19 +680,75 @@ var _ = Describe("User", func() {
 			})
 		})
 
+		When("an error occurs while extracting user ID", func() {
+			BeforeEach(func() {
+				userID = "6117e377b6e7bae09f52c483"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return("", errors.New("an error")).Times(1)
+
+				mockUserService := mocks.NewMockUserService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnauthorized))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user is not allowed to delete the user", func() {
+			BeforeEach(func() {
+				userID = "6117e377b6e7bae09f52c483"
+				actingUserID := "621f5f40e07fdbb81c8221f8"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(actingUserID, nil).Times(1)
+
+				mockUserService := mocks.NewMockUserService(mockCtrl)
+
+				mockUserService.EXPECT().Delete(actingUserID, userID).Return(application.NewForbiddenError()).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusForbidden))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
 		When("an error occurs while deleting user", func() {
 			BeforeEach(func() {
 				userID = "6117e377b6e7bae09f52c483"
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
 				mockUserService := mocks.NewMockUserService(mockCtrl)
 
-				mockUserService.EXPECT().Delete(userID).Return(errors.New("an error")).Times(1)
+				mockUserService.EXPECT().Delete(userID, userID).Return(errors.New("an error")).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				userController = controllers.NewUserController(mockUserService, validationProvider)
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 500 Internal Server Error", func() {
@@ -643,13 +799,16 @@ var _ = Describe("User", func() {
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
 				mockUserService := mocks.NewMockUserService(mockCtrl)
 
-				mockUserService.EXPECT().UpdatePassword(userID, updatePasswordRequest).Return(nil).Times(1)
+				mockUserService.EXPECT().UpdatePassword(userID, userID, updatePasswordRequest).Return(nil).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				userController = controllers.NewUserController(mockUserService, validationProvider)
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 200 OK", func() {
@@ -675,11 +834,14 @@ var _ = Describe("User", func() {
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
 				mockUserService := mocks.NewMockUserService(mockCtrl)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				userController = controllers.NewUserController(mockUserService, validationProvider)
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 422 Unprocessable Entity", func() {
@@ -698,11 +860,14 @@ var _ = Describe("User", func() {
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
 				mockUserService := mocks.NewMockUserService(mockCtrl)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				userController = controllers.NewUserController(mockUserService, validationProvider)
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 400 Bad Request", func() {
@@ -714,6 +879,71 @@ var _ = Describe("User", func() {
 			})
 		})
 
+		When("an error occurs while extracting user ID", func() {
+			BeforeEach(func() {
+				updatePasswordRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/update_password_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				input = bytes.NewBuffer(updatePasswordRequestSerialized)
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return("", errors.New("an error")).Times(1)
+
+				mockUserService := mocks.NewMockUserService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnauthorized))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user is not allowed to update the password", func() {
+			BeforeEach(func() {
+				updatePasswordRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/update_password_request.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				var updatePasswordRequest dtos.PasswordDTO
+				err = json.Unmarshal(updatePasswordRequestSerialized, &updatePasswordRequest)
+				Expect(err).NotTo(HaveOccurred())
+
+				userID = "6117e377b6e7bae09f52c483"
+				input = bytes.NewBuffer(updatePasswordRequestSerialized)
+				actingUserID := "621f5f40e07fdbb81c8221f8"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(actingUserID, nil).Times(1)
+
+				mockUserService := mocks.NewMockUserService(mockCtrl)
+
+				mockUserService.EXPECT().UpdatePassword(actingUserID, userID, updatePasswordRequest).Return(application.NewForbiddenError()).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusForbidden))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
 		When("an error occurs while updating user", func() {
 			BeforeEach(func() {
 				updatePasswordRequestSerialized, err := ioutil.ReadFile("../../../../../test/resources/update_password_request.json")
@@ -728,13 +958,16 @@ var _ = Describe("User", func() {
 
 				mockCtrl = gomock.NewController(GinkgoT())
 
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
 				mockUserService := mocks.NewMockUserService(mockCtrl)
 
-				mockUserService.EXPECT().UpdatePassword(userID, updatePasswordRequest).Return(errors.New("an error")).Times(1)
+				mockUserService.EXPECT().UpdatePassword(userID, userID, updatePasswordRequest).Return(errors.New("an error")).Times(1)
 
 				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
 
-				userController = controllers.NewUserController(mockUserService, validationProvider)
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
 			})
 
 			It("response status code should be 500 Internal Server Error", func() {
@@ -746,4 +979,214 @@ var _ = Describe("User", func() {
 			})
 		})
 	})
+
+	Describe("Verifying the user email", func() {
+		var query string
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var userController *controllers.UserController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupUserRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, userController)
+
+			req := httptest.NewRequest(fiber.MethodGet, routes.VERIFY_EMAIL_ROUTE+query, nil)
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the email is verified with success", func() {
+			var expectedVerifyEmailResult entities.User
+
+			BeforeEach(func() {
+				userSerialized, err := ioutil.ReadFile("../../../../../test/resources/user.json")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = json.Unmarshal(userSerialized, &expectedVerifyEmailResult)
+				Expect(err).NotTo(HaveOccurred())
+
+				query = "?token=verification-token"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockUserService := mocks.NewMockUserService(mockCtrl)
+				mockUserService.EXPECT().VerifyEmail("verification-token").Return(expectedVerifyEmailResult, nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 200 OK", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusOK))
+			})
+
+			It("response body should be equal to userService.VerifyEmail result", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var user entities.User
+				err = json.Unmarshal(body, &user)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(user).To(Equal(expectedVerifyEmailResult))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the verification link is not valid", func() {
+			BeforeEach(func() {
+				query = "?token=tampered-token"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockUserService := mocks.NewMockUserService(mockCtrl)
+				mockUserService.EXPECT().VerifyEmail("tampered-token").Return(entities.User{}, domain.NewBadRequestError("link de verificação inválido ou expirado")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 400 Bad Request", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusBadRequest))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the token is missing", func() {
+			BeforeEach(func() {
+				query = ""
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+
+				mockUserService := mocks.NewMockUserService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 422 Unprocessable Entity", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnprocessableEntity))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
+
+	Describe("Resending the verification email", func() {
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var userController *controllers.UserController
+
+		JustBeforeEach(func() {
+			var err error
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler: infrastructure.Handler,
+			})
+
+			routes.SetupUserRoutes(app, func(c *fiber.Ctx) error { return c.Next() }, userController)
+
+			req := httptest.NewRequest(fiber.MethodPost, routes.RESEND_VERIFICATION_ROUTE, nil)
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the verification email is sent with success", func() {
+			BeforeEach(func() {
+				userID := "6117e377b6e7bae09f52c483"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockUserService := mocks.NewMockUserService(mockCtrl)
+				mockUserService.EXPECT().ResendVerification(userID).Return(nil).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 204 No Content", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusNoContent))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the email is already verified", func() {
+			BeforeEach(func() {
+				userID := "6117e377b6e7bae09f52c483"
+
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return(userID, nil).Times(1)
+
+				mockUserService := mocks.NewMockUserService(mockCtrl)
+				mockUserService.EXPECT().ResendVerification(userID).Return(domain.NewConflictError("o e-mail já foi verificado")).Times(1)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 409 Conflict", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusConflict))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+
+		When("the user is not authenticated", func() {
+			BeforeEach(func() {
+				mockCtrl = gomock.NewController(GinkgoT())
+
+				mockAuthenticator := authMocks.NewMockAuthenticator(mockCtrl)
+				mockAuthenticator.EXPECT().ExtractUserID(gomock.Any()).Return("", application.NewUnauthorizedError("token inválido")).Times(1)
+
+				mockUserService := mocks.NewMockUserService(mockCtrl)
+
+				validationProvider := goplayground.NewGoPlaygroundValidatorProvider()
+
+				userController = controllers.NewUserController(mockUserService, mockAuthenticator, validationProvider)
+			})
+
+			It("response status code should be 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(fiber.StatusUnauthorized))
+			})
+
+			AfterEach(func() {
+				mockCtrl.Finish()
+			})
+		})
+	})
 })
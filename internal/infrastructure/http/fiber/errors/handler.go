@@ -1,6 +1,9 @@
 package errors
 
 import (
+	"math"
+	"strconv"
+
 	"github.com/gofiber/fiber/v2"
 	domain "github.com/waliqueiroz/letmeask-api/internal/domain/errors"
 )
@@ -10,6 +13,11 @@ func Handler(ctx *fiber.Ctx, err error) error {
 	switch e := err.(type) {
 	case *fiber.Error:
 		return sendError(ctx, e.Code, e.Error())
+	case *domain.RateLimitError:
+		if e.RetryAfter > 0 {
+			ctx.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(e.RetryAfter.Seconds()))))
+		}
+		return sendError(ctx, e.Code(), err.Error())
 	case domain.HTTPError:
 		return sendError(ctx, e.Code(), err.Error())
 	default:
@@ -3,11 +3,55 @@ package middlewares
 import (
 	"github.com/gofiber/fiber/v2"
 	jwtware "github.com/gofiber/jwt/v2"
+	"github.com/waliqueiroz/letmeask-api/internal/application/providers"
+	"github.com/waliqueiroz/letmeask-api/internal/application/services"
 	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/configurations"
 )
 
-func NewAuthMiddleware(configuration configurations.Configuration) fiber.Handler {
-	return jwtware.New(jwtware.Config{
+func NewAuthMiddleware(configuration configurations.Configuration, authenticator providers.Authenticator, authService services.AuthService) fiber.Handler {
+	return jwtware.New(newJwtConfig(configuration, authenticator, authService))
+}
+
+// NewOptionalAuthMiddleware lets requests without a token through, so public
+// routes can still tell who is asking whenever a token is sent.
+func NewOptionalAuthMiddleware(configuration configurations.Configuration, authenticator providers.Authenticator, authService services.AuthService) fiber.Handler {
+	config := newJwtConfig(configuration, authenticator, authService)
+	config.Filter = func(ctx *fiber.Ctx) bool {
+		return ctx.Get(fiber.HeaderAuthorization) == ""
+	}
+
+	return jwtware.New(config)
+}
+
+// NewStreamAuthMiddleware is the optional auth of the routes browsers open with
+// WebSocket or EventSource. Those can not set headers, so the token may also
+// come in the access_token query parameter.
+func NewStreamAuthMiddleware(configuration configurations.Configuration, authenticator providers.Authenticator, authService services.AuthService) fiber.Handler {
+	config := newJwtConfig(configuration, authenticator, authService)
+	config.TokenLookup = "header:" + fiber.HeaderAuthorization + ",query:access_token"
+	config.Filter = func(ctx *fiber.Ctx) bool {
+		return ctx.Get(fiber.HeaderAuthorization) == "" && ctx.Query("access_token") == ""
+	}
+
+	return jwtware.New(config)
+}
+
+func newJwtConfig(configuration configurations.Configuration, authenticator providers.Authenticator, authService services.AuthService) jwtware.Config {
+	return jwtware.Config{
 		SigningKey: []byte(configuration.Auth.SecretKey),
-	})
+		// A valid signature is not enough: the token may have been revoked,
+		// its user deleted or the password changed since it was issued.
+		SuccessHandler: func(ctx *fiber.Ctx) error {
+			claims, err := authenticator.ExtractClaims(ctx.Locals("user"))
+			if err != nil {
+				return err
+			}
+
+			if err := authService.ValidateToken(claims); err != nil {
+				return err
+			}
+
+			return ctx.Next()
+		},
+	}
 }
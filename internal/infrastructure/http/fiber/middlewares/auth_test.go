@@ -0,0 +1,129 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/waliqueiroz/letmeask-api/internal/application/providers"
+	"github.com/waliqueiroz/letmeask-api/internal/application/services/mocks"
+	authMocks "github.com/waliqueiroz/letmeask-api/internal/infrastructure/authentication/mocks"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/configurations"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/http/fiber/middlewares"
+)
+
+var _ = Describe("Auth", func() {
+
+	Describe("Authenticating a stream", func() {
+		const secretKey = "secret"
+
+		var target string
+		var authorization string
+		var authenticated bool
+		var response *http.Response
+		var mockCtrl *gomock.Controller
+		var mockAuthenticator *authMocks.MockAuthenticator
+		var mockAuthService *mocks.MockAuthService
+
+		sign := func(key string) string {
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+				"user_id": "621f5e02e07fdbb81c8221f5",
+			}).SignedString([]byte(key))
+			Expect(err).NotTo(HaveOccurred())
+
+			return token
+		}
+
+		BeforeEach(func() {
+			authorization = ""
+			authenticated = false
+
+			mockCtrl = gomock.NewController(GinkgoT())
+			mockAuthenticator = authMocks.NewMockAuthenticator(mockCtrl)
+			mockAuthService = mocks.NewMockAuthService(mockCtrl)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			configuration := configurations.Configuration{
+				Auth: configurations.Auth{SecretKey: secretKey},
+			}
+
+			app := fiber.New()
+			app.Get("/feed", middlewares.NewStreamAuthMiddleware(configuration, mockAuthenticator, mockAuthService), func(ctx *fiber.Ctx) error {
+				authenticated = ctx.Locals("user") != nil
+				return ctx.SendStatus(fiber.StatusOK)
+			})
+
+			req := httptest.NewRequest(fiber.MethodGet, target, nil)
+			if authorization != "" {
+				req.Header.Set(fiber.HeaderAuthorization, authorization)
+			}
+
+			response, err = app.Test(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			mockCtrl.Finish()
+		})
+
+		When("the token is sent in the query string", func() {
+			BeforeEach(func() {
+				target = "/feed?access_token=" + sign(secretKey)
+
+				claims := providers.TokenClaims{UserID: "621f5e02e07fdbb81c8221f5"}
+				mockAuthenticator.EXPECT().ExtractClaims(gomock.Any()).Return(claims, nil).Times(1)
+				mockAuthService.EXPECT().ValidateToken(claims).Return(nil).Times(1)
+			})
+
+			It("should identify the viewer", func() {
+				Expect(response.StatusCode).Should(Equal(fiber.StatusOK))
+				Expect(authenticated).Should(BeTrue())
+			})
+		})
+
+		When("the token is sent in the authorization header", func() {
+			BeforeEach(func() {
+				target = "/feed"
+				authorization = "Bearer " + sign(secretKey)
+
+				claims := providers.TokenClaims{UserID: "621f5e02e07fdbb81c8221f5"}
+				mockAuthenticator.EXPECT().ExtractClaims(gomock.Any()).Return(claims, nil).Times(1)
+				mockAuthService.EXPECT().ValidateToken(claims).Return(nil).Times(1)
+			})
+
+			It("should identify the viewer", func() {
+				Expect(response.StatusCode).Should(Equal(fiber.StatusOK))
+				Expect(authenticated).Should(BeTrue())
+			})
+		})
+
+		When("no token is sent", func() {
+			BeforeEach(func() {
+				target = "/feed"
+			})
+
+			It("should let the anonymous viewer through", func() {
+				Expect(response.StatusCode).Should(Equal(fiber.StatusOK))
+				Expect(authenticated).Should(BeFalse())
+			})
+		})
+
+		When("the token in the query string has an invalid signature", func() {
+			BeforeEach(func() {
+				target = "/feed?access_token=" + sign("another secret")
+			})
+
+			It("should respond with status 401", func() {
+				Expect(response.StatusCode).Should(Equal(fiber.StatusUnauthorized))
+				Expect(authenticated).Should(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,64 @@
+package middlewares
+
+import (
+	"log"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/waliqueiroz/letmeask-api/internal/application/dtos"
+	"github.com/waliqueiroz/letmeask-api/internal/application/providers"
+	domain "github.com/waliqueiroz/letmeask-api/internal/domain/errors"
+)
+
+// NewRateLimitMiddleware limits the requests coming from each IP address. A
+// limit of zero requests turns it off.
+func NewRateLimitMiddleware(limiter providers.RateLimiter, limit providers.RateLimit) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		if err := allow(limiter, "ip:"+ctx.IP(), limit); err != nil {
+			return err
+		}
+
+		return ctx.Next()
+	}
+}
+
+// NewLoginRateLimitMiddleware limits the login attempts both from each IP
+// address and for each email, so that neither one address trying many
+// accounts nor many addresses trying one account get far.
+func NewLoginRateLimitMiddleware(limiter providers.RateLimiter, limit providers.RateLimit) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		if err := allow(limiter, "login:ip:"+ctx.IP(), limit); err != nil {
+			return err
+		}
+
+		// A body that can not be parsed is left for the controller to refuse.
+		var credentials dtos.CredentialsDTO
+		if err := ctx.BodyParser(&credentials); err == nil && credentials.Email != "" {
+			if err := allow(limiter, "login:email:"+strings.ToLower(credentials.Email), limit); err != nil {
+				return err
+			}
+		}
+
+		return ctx.Next()
+	}
+}
+
+func allow(limiter providers.RateLimiter, key string, limit providers.RateLimit) error {
+	if limit.Requests <= 0 || limit.Period <= 0 {
+		return nil
+	}
+
+	allowed, retryAfter, err := limiter.Allow(key, limit)
+	if err != nil {
+		// The API stays available when the limiter is not, since refusing
+		// every request would be worse than not limiting them for a while.
+		log.Println(err)
+		return nil
+	}
+
+	if !allowed {
+		return domain.NewRateLimitError(retryAfter, "muitas requisições, tente novamente mais tarde.")
+	}
+
+	return nil
+}
@@ -6,7 +6,11 @@ import (
 )
 
 const LOGIN_ROUTE = "/login"
+const REFRESH_ROUTE = "/refresh"
+const LOGOUT_ROUTE = "/logout"
 
-func SetupAuthRoutes(router fiber.Router, authController *controllers.AuthController) {
-	router.Post(LOGIN_ROUTE, authController.Login)
+func SetupAuthRoutes(router fiber.Router, authMiddleware fiber.Handler, loginRateLimitMiddleware fiber.Handler, authController *controllers.AuthController) {
+	router.Post(LOGIN_ROUTE, loginRateLimitMiddleware, authController.Login)
+	router.Post(REFRESH_ROUTE, authController.Refresh)
+	router.Post(LOGOUT_ROUTE, authMiddleware, authController.Logout)
 }
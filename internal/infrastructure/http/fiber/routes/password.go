@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/http/fiber/controllers"
+)
+
+const (
+	FORGOT_PASSWORD_ROUTE = "/password/forgot"
+	RESET_PASSWORD_ROUTE  = "/password/reset"
+)
+
+func SetupPasswordRoutes(router fiber.Router, passwordController *controllers.PasswordController) {
+	router.Post(FORGOT_PASSWORD_ROUTE, passwordController.Forgot)
+	router.Post(RESET_PASSWORD_ROUTE, passwordController.Reset)
+}
@@ -5,22 +5,70 @@ import (
 	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/http/fiber/controllers"
 )
 
+const FIND_ALL_ROOMS_ROUTE = "/rooms"
 const CREATE_ROOM_ROUTE = "/rooms"
+const FIND_ROOMS_BY_AUTHOR_ROUTE = "/users/:userID/rooms"
+const FIND_ROOM_BY_CODE_ROUTE = "/rooms/code/:code"
 const FIND_ROOM_BY_ID_ROUTE = "/rooms/:roomID"
+const JOIN_ROOM_ROUTE = "/rooms/:roomID/join"
+const ROOM_FEED_ROUTE = "/rooms/:roomID/feed"
+const ROOM_EVENTS_ROUTE = "/rooms/:roomID/events"
 const END_ROOM_ROUTE = "/rooms/:roomID"
+const REOPEN_ROOM_ROUTE = "/rooms/:roomID/reopen"
+const FIND_QUESTIONS_ROUTE = "/rooms/:roomID/questions"
+const FIND_PENDING_QUESTIONS_ROUTE = "/rooms/:roomID/questions/pending"
+const FIND_DELETED_QUESTIONS_ROUTE = "/rooms/:roomID/questions/deleted"
 const CREATE_QUESTION_ROUTE = "/rooms/:roomID/questions"
 const LIKE_QUESTION_ROUTE = "/rooms/:roomID/questions/:questionID/likes"
 const DESLIKE_QUESTION_ROUTE = "/rooms/:roomID/questions/:questionID/likes/:likeID"
 const UPDATE_QUESTION_ROUTE = "/rooms/:roomID/questions/:questionID"
 const DELETE_QUESTION_ROUTE = "/rooms/:roomID/questions/:questionID"
+const EDIT_QUESTION_ROUTE = "/rooms/:roomID/questions/:questionID/content"
+const FIND_QUESTION_REVISIONS_ROUTE = "/rooms/:roomID/questions/:questionID/revisions"
+const APPROVE_QUESTION_ROUTE = "/rooms/:roomID/questions/:questionID/approve"
+const REJECT_QUESTION_ROUTE = "/rooms/:roomID/questions/:questionID/reject"
+const CREATE_REPLY_ROUTE = "/rooms/:roomID/questions/:questionID/replies"
+const UPDATE_REPLY_ROUTE = "/rooms/:roomID/questions/:questionID/replies/:replyID"
+const DELETE_REPLY_ROUTE = "/rooms/:roomID/questions/:questionID/replies/:replyID"
+const CREATE_POLL_ROUTE = "/rooms/:roomID/polls"
+const OPEN_POLL_ROUTE = "/rooms/:roomID/polls/:pollID/open"
+const CLOSE_POLL_ROUTE = "/rooms/:roomID/polls/:pollID/close"
+const VOTE_POLL_ROUTE = "/rooms/:roomID/polls/:pollID/votes"
+const UPDATE_ROOM_SETTINGS_ROUTE = "/rooms/:roomID/settings"
+const ADD_MEMBER_ROUTE = "/rooms/:roomID/members"
+const REMOVE_MEMBER_ROUTE = "/rooms/:roomID/members/:memberID"
 
-func SetupRoomRoutes(router fiber.Router, authMiddleware fiber.Handler, roomController *controllers.RoomController) {
+func SetupRoomRoutes(router fiber.Router, authMiddleware fiber.Handler, optionalAuthMiddleware fiber.Handler, streamAuthMiddleware fiber.Handler, roomController *controllers.RoomController) {
+	router.Get(FIND_ALL_ROOMS_ROUTE, authMiddleware, roomController.Index)
 	router.Post(CREATE_ROOM_ROUTE, authMiddleware, roomController.Create)
-	router.Get(FIND_ROOM_BY_ID_ROUTE, roomController.FindByID)
+	router.Get(FIND_ROOMS_BY_AUTHOR_ROUTE, authMiddleware, roomController.FindByAuthor)
+	router.Get(FIND_ROOM_BY_CODE_ROUTE, optionalAuthMiddleware, roomController.FindByCode)
+	router.Get(FIND_ROOM_BY_ID_ROUTE, optionalAuthMiddleware, roomController.FindByID)
+	router.Post(JOIN_ROOM_ROUTE, optionalAuthMiddleware, roomController.JoinRoom)
+	router.Get(ROOM_FEED_ROUTE, streamAuthMiddleware, roomController.Feed)
+	router.Get(ROOM_EVENTS_ROUTE, streamAuthMiddleware, roomController.Stream)
 	router.Delete(END_ROOM_ROUTE, authMiddleware, roomController.EndRoom)
+	router.Post(REOPEN_ROOM_ROUTE, authMiddleware, roomController.ReopenRoom)
+	router.Get(FIND_QUESTIONS_ROUTE, optionalAuthMiddleware, roomController.FindQuestions)
+	router.Get(FIND_PENDING_QUESTIONS_ROUTE, authMiddleware, roomController.FindPendingQuestions)
+	router.Get(FIND_DELETED_QUESTIONS_ROUTE, authMiddleware, roomController.FindDeletedQuestions)
 	router.Post(CREATE_QUESTION_ROUTE, authMiddleware, roomController.CreateQuestion)
 	router.Post(LIKE_QUESTION_ROUTE, authMiddleware, roomController.LikeQuestion)
 	router.Delete(DESLIKE_QUESTION_ROUTE, authMiddleware, roomController.DeslikeQuestion)
 	router.Patch(UPDATE_QUESTION_ROUTE, authMiddleware, roomController.UpdateQuestion)
 	router.Delete(DELETE_QUESTION_ROUTE, authMiddleware, roomController.DeleteQuestion)
+	router.Put(EDIT_QUESTION_ROUTE, authMiddleware, roomController.EditQuestion)
+	router.Get(FIND_QUESTION_REVISIONS_ROUTE, authMiddleware, roomController.FindQuestionRevisions)
+	router.Post(APPROVE_QUESTION_ROUTE, authMiddleware, roomController.ApproveQuestion)
+	router.Post(REJECT_QUESTION_ROUTE, authMiddleware, roomController.RejectQuestion)
+	router.Post(CREATE_REPLY_ROUTE, authMiddleware, roomController.CreateReply)
+	router.Patch(UPDATE_REPLY_ROUTE, authMiddleware, roomController.UpdateReply)
+	router.Delete(DELETE_REPLY_ROUTE, authMiddleware, roomController.DeleteReply)
+	router.Post(CREATE_POLL_ROUTE, authMiddleware, roomController.CreatePoll)
+	router.Post(OPEN_POLL_ROUTE, authMiddleware, roomController.OpenPoll)
+	router.Post(CLOSE_POLL_ROUTE, authMiddleware, roomController.ClosePoll)
+	router.Post(VOTE_POLL_ROUTE, authMiddleware, roomController.Vote)
+	router.Patch(UPDATE_ROOM_SETTINGS_ROUTE, authMiddleware, roomController.UpdateSettings)
+	router.Post(ADD_MEMBER_ROUTE, authMiddleware, roomController.AddMember)
+	router.Delete(REMOVE_MEMBER_ROUTE, authMiddleware, roomController.RemoveMember)
 }
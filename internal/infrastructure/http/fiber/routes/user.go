@@ -12,6 +12,8 @@ const (
 	UPDATE_USER_ROUTE          = "/users/:userID"
 	DELETE_USER_ROUTE          = "/users/:userID"
 	UPDATE_USER_PASSWORD_ROUTE = "/users/:userID/update-password"
+	VERIFY_EMAIL_ROUTE         = "/verify-email"
+	RESEND_VERIFICATION_ROUTE  = "/verify-email/resend"
 )
 
 func SetupUserRoutes(router fiber.Router, authMiddleware fiber.Handler, userController *controllers.UserController) {
@@ -21,4 +23,6 @@ func SetupUserRoutes(router fiber.Router, authMiddleware fiber.Handler, userCont
 	router.Put(UPDATE_USER_ROUTE, authMiddleware, userController.Update)
 	router.Delete(DELETE_USER_ROUTE, authMiddleware, userController.Delete)
 	router.Post(UPDATE_USER_PASSWORD_ROUTE, authMiddleware, userController.UpdatePassword)
+	router.Get(VERIFY_EMAIL_ROUTE, userController.VerifyEmail)
+	router.Post(RESEND_VERIFICATION_ROUTE, authMiddleware, userController.ResendVerification)
 }
@@ -0,0 +1,37 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/waliqueiroz/letmeask-api/internal/application/dtos"
+)
+
+// MemoryProvider keeps every mail it is asked to send instead of delivering
+// it, which is handy for tests and for running the API locally.
+type MemoryProvider struct {
+	mutex sync.Mutex
+	sent  []dtos.MailDTO
+}
+
+func NewMemoryProvider() *MemoryProvider {
+	return &MemoryProvider{}
+}
+
+func (provider *MemoryProvider) Send(mail dtos.MailDTO) error {
+	provider.mutex.Lock()
+	defer provider.mutex.Unlock()
+
+	provider.sent = append(provider.sent, mail)
+
+	return nil
+}
+
+func (provider *MemoryProvider) Sent() []dtos.MailDTO {
+	provider.mutex.Lock()
+	defer provider.mutex.Unlock()
+
+	sent := make([]dtos.MailDTO, len(provider.sent))
+	copy(sent, provider.sent)
+
+	return sent
+}
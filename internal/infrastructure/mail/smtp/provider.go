@@ -0,0 +1,51 @@
+package smtp
+
+import (
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/waliqueiroz/letmeask-api/internal/application/dtos"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/configurations"
+)
+
+type SMTPProvider struct {
+	configuration configurations.Configuration
+}
+
+func NewSMTPProvider(configuration configurations.Configuration) *SMTPProvider {
+	return &SMTPProvider{
+		configuration,
+	}
+}
+
+func (provider *SMTPProvider) Send(mail dtos.MailDTO) error {
+	config := provider.configuration.Mail
+
+	// Local stand-ins such as MailHog accept mail without credentials, so
+	// authentication is only attempted when a username is configured.
+	var auth smtp.Auth
+	if config.Username != "" {
+		auth = smtp.PlainAuth("", config.Username, config.Password, config.Host)
+	}
+
+	address := net.JoinHostPort(config.Host, config.Port)
+
+	return smtp.SendMail(address, auth, config.From, []string{mail.To}, provider.buildMessage(mail))
+}
+
+func (provider *SMTPProvider) buildMessage(mail dtos.MailDTO) []byte {
+	var message strings.Builder
+
+	fmt.Fprintf(&message, "From: %s\r\n", provider.configuration.Mail.From)
+	fmt.Fprintf(&message, "To: %s\r\n", mail.To)
+	fmt.Fprintf(&message, "Subject: %s\r\n", mime.QEncoding.Encode("UTF-8", mail.Subject))
+	message.WriteString("MIME-Version: 1.0\r\n")
+	message.WriteString("Content-Type: text/plain; charset=\"UTF-8\"\r\n")
+	message.WriteString("\r\n")
+	message.WriteString(mail.Body)
+
+	return []byte(message.String())
+}
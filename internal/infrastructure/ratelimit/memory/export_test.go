@@ -0,0 +1,16 @@
+package memory
+
+import "time"
+
+const SweepInterval = sweepInterval
+
+func (limiter *MemoryRateLimiter) SetClock(now func() time.Time) {
+	limiter.now = now
+}
+
+func (limiter *MemoryRateLimiter) Buckets() int {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	return len(limiter.buckets)
+}
@@ -0,0 +1,83 @@
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/waliqueiroz/letmeask-api/internal/application/providers"
+)
+
+// sweepInterval is how often buckets that are full again are forgotten.
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+	period    time.Duration
+}
+
+// MemoryRateLimiter keeps a token bucket per key in the process memory. It is
+// meant for a single instance deployment or for development, since every
+// instance counts on its own.
+type MemoryRateLimiter struct {
+	mutex   sync.Mutex
+	buckets map[string]*bucket
+	sweptAt time.Time
+	now     func() time.Time
+}
+
+func NewMemoryRateLimiter() *MemoryRateLimiter {
+	return &MemoryRateLimiter{
+		buckets: make(map[string]*bucket),
+		sweptAt: time.Now(),
+		now:     time.Now,
+	}
+}
+
+func (limiter *MemoryRateLimiter) Allow(key string, limit providers.RateLimit) (bool, time.Duration, error) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	now := limiter.now()
+
+	if now.Sub(limiter.sweptAt) >= sweepInterval {
+		limiter.sweep(now)
+	}
+
+	capacity := float64(limit.Requests)
+	rate := capacity / limit.Period.Seconds()
+
+	b, ok := limiter.buckets[key]
+	if !ok {
+		b = &bucket{tokens: capacity, updatedAt: now}
+		limiter.buckets[key] = b
+	}
+
+	b.tokens += now.Sub(b.updatedAt).Seconds() * rate
+	if b.tokens > capacity {
+		b.tokens = capacity
+	}
+
+	b.updatedAt = now
+	b.period = limit.Period
+
+	if b.tokens < 1 {
+		return false, time.Duration((1 - b.tokens) / rate * float64(time.Second)), nil
+	}
+
+	b.tokens--
+
+	return true, 0, nil
+}
+
+// sweep forgets the buckets that had time to fill up again, since a new
+// bucket starts full anyway.
+func (limiter *MemoryRateLimiter) sweep(now time.Time) {
+	for key, b := range limiter.buckets {
+		if now.Sub(b.updatedAt) >= b.period {
+			delete(limiter.buckets, key)
+		}
+	}
+
+	limiter.sweptAt = now
+}
@@ -0,0 +1,136 @@
+package memory_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/waliqueiroz/letmeask-api/internal/application/providers"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/ratelimit/memory"
+)
+
+const key = "127.0.0.1"
+
+var _ = Describe("MemoryRateLimiter", func() {
+	var limiter *memory.MemoryRateLimiter
+	var now time.Time
+
+	limit := providers.RateLimit{Requests: 2, Period: 10 * time.Second}
+
+	allow := func(key string) (bool, time.Duration) {
+		allowed, retryAfter, err := limiter.Allow(key, limit)
+		Expect(err).NotTo(HaveOccurred())
+
+		return allowed, retryAfter
+	}
+
+	BeforeEach(func() {
+		limiter = memory.NewMemoryRateLimiter()
+		now = time.Now()
+		limiter.SetClock(func() time.Time {
+			return now
+		})
+	})
+
+	Describe("Allow", func() {
+		When("the key still has requests left", func() {
+			It("should allow the requests up to the limit", func() {
+				for i := 0; i < limit.Requests; i++ {
+					allowed, retryAfter := allow(key)
+
+					Expect(allowed).Should(BeTrue())
+					Expect(retryAfter).Should(BeZero())
+				}
+			})
+		})
+
+		When("the key used up its requests", func() {
+			BeforeEach(func() {
+				allow(key)
+				allow(key)
+			})
+
+			It("should deny the request until a token is back", func() {
+				allowed, retryAfter := allow(key)
+
+				Expect(allowed).Should(BeFalse())
+				Expect(retryAfter).Should(BeNumerically("~", 5*time.Second, time.Millisecond))
+			})
+
+			It("should shorten the wait as the bucket refills", func() {
+				now = now.Add(2 * time.Second)
+
+				allowed, retryAfter := allow(key)
+
+				Expect(allowed).Should(BeFalse())
+				Expect(retryAfter).Should(BeNumerically("~", 3*time.Second, time.Millisecond))
+			})
+
+			It("should allow the request once a token is back", func() {
+				now = now.Add(5 * time.Second)
+
+				allowed, _ := allow(key)
+				Expect(allowed).Should(BeTrue())
+
+				allowed, _ = allow(key)
+				Expect(allowed).Should(BeFalse())
+			})
+
+			It("should not limit the other keys", func() {
+				allowed, _ := allow("127.0.0.2")
+
+				Expect(allowed).Should(BeTrue())
+			})
+		})
+
+		When("the key stays idle for longer than it takes to refill", func() {
+			It("should not hold more tokens than the limit", func() {
+				allow(key)
+				now = now.Add(time.Hour)
+
+				for i := 0; i < limit.Requests; i++ {
+					allowed, _ := allow(key)
+					Expect(allowed).Should(BeTrue())
+				}
+
+				allowed, _ := allow(key)
+				Expect(allowed).Should(BeFalse())
+			})
+		})
+	})
+
+	Describe("Sweep", func() {
+		BeforeEach(func() {
+			allow(key)
+		})
+
+		When("a bucket had time to fill up again", func() {
+			It("should be forgotten on the next sweep", func() {
+				now = now.Add(memory.SweepInterval)
+				allow("127.0.0.2")
+
+				Expect(limiter.Buckets()).Should(Equal(1))
+			})
+		})
+
+		When("a bucket is still refilling", func() {
+			It("should be kept", func() {
+				now = now.Add(memory.SweepInterval - time.Second)
+				allow(key)
+				now = now.Add(time.Second)
+				allow("127.0.0.2")
+
+				Expect(limiter.Buckets()).Should(Equal(2))
+			})
+		})
+
+		When("the sweep interval has not passed", func() {
+			It("should keep the full buckets", func() {
+				now = now.Add(limit.Period)
+				allow("127.0.0.2")
+
+				Expect(limiter.Buckets()).Should(Equal(2))
+			})
+		})
+	})
+})
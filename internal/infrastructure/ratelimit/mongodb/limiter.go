@@ -0,0 +1,81 @@
+package mongodb
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/waliqueiroz/letmeask-api/internal/application/providers"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type rateLimitBucket struct {
+	Tokens  float64 `bson:"tokens"`
+	Allowed bool    `bson:"allowed"`
+}
+
+// MongoRateLimiter shares the token buckets between every instance of the
+// API. Each request refills and takes from its bucket in a single update, so
+// instances never overwrite each other, and a TTL index on expires_at drops
+// the buckets that had time to fill up again.
+type MongoRateLimiter struct {
+	rateLimitCollection *mongo.Collection
+}
+
+func NewMongoRateLimiter(db *mongo.Database) *MongoRateLimiter {
+	return &MongoRateLimiter{
+		rateLimitCollection: db.Collection("rate_limits"),
+	}
+}
+
+func (limiter *MongoRateLimiter) Allow(key string, limit providers.RateLimit) (bool, time.Duration, error) {
+	now := time.Now()
+
+	capacity := float64(limit.Requests)
+	// Subtracting dates in MongoDB gives milliseconds, so the rate is how
+	// many requests are refilled per millisecond.
+	rate := capacity / float64(limit.Period.Milliseconds())
+
+	refilled := bson.M{"$min": bson.A{
+		capacity,
+		bson.M{"$add": bson.A{
+			bson.M{"$ifNull": bson.A{"$tokens", capacity}},
+			bson.M{"$multiply": bson.A{
+				bson.M{"$subtract": bson.A{now, bson.M{"$ifNull": bson.A{"$updated_at", now}}}},
+				rate,
+			}},
+		}},
+	}}
+
+	update := bson.A{
+		bson.M{"$set": bson.M{"tokens": refilled}},
+		bson.M{"$set": bson.M{"allowed": bson.M{"$gte": bson.A{"$tokens", 1}}}},
+		bson.M{"$set": bson.M{
+			"tokens":     bson.M{"$cond": bson.A{"$allowed", bson.M{"$subtract": bson.A{"$tokens", 1}}, "$tokens"}},
+			"updated_at": now,
+			"expires_at": now.Add(limit.Period),
+		}},
+	}
+
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var bucket rateLimitBucket
+
+	err := limiter.rateLimitCollection.FindOneAndUpdate(context.Background(), bson.M{"_id": key}, update, opts).Decode(&bucket)
+	// Two requests creating the same bucket at once make one of the upserts
+	// fail, and trying again finds the bucket the other one created.
+	if mongo.IsDuplicateKeyError(err) {
+		err = limiter.rateLimitCollection.FindOneAndUpdate(context.Background(), bson.M{"_id": key}, update, opts).Decode(&bucket)
+	}
+	if err != nil {
+		return false, 0, err
+	}
+
+	if !bucket.Allowed {
+		return false, time.Duration(math.Ceil((1-bucket.Tokens)/rate)) * time.Millisecond, nil
+	}
+
+	return true, 0, nil
+}
@@ -0,0 +1,48 @@
+package memory
+
+import (
+	"sync"
+	"time"
+)
+
+// MemoryRevocationStore keeps revoked token IDs in the process memory. It is
+// meant for a single instance deployment or for development, since every
+// instance has its own list and it is lost on restart.
+type MemoryRevocationStore struct {
+	mutex   sync.RWMutex
+	revoked map[string]time.Time
+}
+
+func NewMemoryRevocationStore() *MemoryRevocationStore {
+	return &MemoryRevocationStore{
+		revoked: make(map[string]time.Time),
+	}
+}
+
+func (store *MemoryRevocationStore) Revoke(tokenID string, expiresAt time.Time) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	now := time.Now()
+
+	// A token past its expiration is refused anyway, so there is no reason to
+	// keep remembering it.
+	for id, expiration := range store.revoked {
+		if !now.Before(expiration) {
+			delete(store.revoked, id)
+		}
+	}
+
+	store.revoked[tokenID] = expiresAt
+
+	return nil
+}
+
+func (store *MemoryRevocationStore) IsRevoked(tokenID string) (bool, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	_, ok := store.revoked[tokenID]
+
+	return ok, nil
+}
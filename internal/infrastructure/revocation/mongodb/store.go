@@ -0,0 +1,49 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoRevocationStore shares the revoked token IDs between every instance
+// of the API. Entries are removed by a TTL index on expires_at once the
+// token would have expired anyway.
+type MongoRevocationStore struct {
+	revokedTokenCollection *mongo.Collection
+}
+
+func NewMongoRevocationStore(db *mongo.Database) *MongoRevocationStore {
+	return &MongoRevocationStore{
+		revokedTokenCollection: db.Collection("revoked_tokens"),
+	}
+}
+
+func (store *MongoRevocationStore) Revoke(tokenID string, expiresAt time.Time) error {
+	filter := bson.M{"_id": tokenID}
+
+	update := bson.M{
+		"$set": bson.M{
+			"expires_at": expiresAt,
+		},
+		"$setOnInsert": bson.M{
+			"revoked_at": time.Now(),
+		},
+	}
+
+	_, err := store.revokedTokenCollection.UpdateOne(context.Background(), filter, update, options.Update().SetUpsert(true))
+
+	return err
+}
+
+func (store *MongoRevocationStore) IsRevoked(tokenID string) (bool, error) {
+	count, err := store.revokedTokenCollection.CountDocuments(context.Background(), bson.M{"_id": tokenID}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,38 @@
+package ticker
+
+import (
+	"log"
+	"time"
+
+	"github.com/waliqueiroz/letmeask-api/internal/application/services"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/configurations"
+)
+
+// RoomScheduler ends the rooms whose scheduled end has passed. Every instance
+// runs its own, which is harmless since ending a room twice changes nothing.
+type RoomScheduler struct {
+	configuration configurations.Configuration
+	roomService   services.RoomService
+}
+
+func NewRoomScheduler(configuration configurations.Configuration, roomService services.RoomService) *RoomScheduler {
+	return &RoomScheduler{
+		configuration,
+		roomService,
+	}
+}
+
+// Start checks for due rooms in the background for as long as the process
+// runs.
+func (scheduler *RoomScheduler) Start() {
+	go func() {
+		ticker := time.NewTicker(scheduler.configuration.Room.SchedulerInterval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if err := scheduler.roomService.EndDueRooms(); err != nil {
+				log.Println(err)
+			}
+		}
+	}()
+}
@@ -0,0 +1,13 @@
+package hmac_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestHmac(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Hmac Suite")
+}
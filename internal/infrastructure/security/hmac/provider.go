@@ -0,0 +1,83 @@
+package hmac
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/configurations"
+)
+
+var (
+	errMalformedToken   = errors.New("malformed token")
+	errInvalidSignature = errors.New("invalid signature")
+	errExpiredToken     = errors.New("expired token")
+)
+
+type HmacProvider struct {
+	configuration configurations.Configuration
+}
+
+func NewHmacProvider(configuration configurations.Configuration) *HmacProvider {
+	return &HmacProvider{
+		configuration,
+	}
+}
+
+// Sign produces "<value>.<expiration>.<signature>", each part base64 URL
+// encoded so the token can travel in a query string untouched.
+func (provider *HmacProvider) Sign(value string, expiresAt time.Time) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(value)) + "." + strconv.FormatInt(expiresAt.Unix(), 10)
+
+	return payload + "." + base64.RawURLEncoding.EncodeToString(provider.mac(payload))
+}
+
+func (provider *HmacProvider) Verify(token string) (string, error) {
+	separator := strings.LastIndex(token, ".")
+	if separator == -1 {
+		return "", errMalformedToken
+	}
+
+	payload := token[:separator]
+
+	signature, err := base64.RawURLEncoding.DecodeString(token[separator+1:])
+	if err != nil {
+		return "", errMalformedToken
+	}
+
+	if !hmac.Equal(signature, provider.mac(payload)) {
+		return "", errInvalidSignature
+	}
+
+	parts := strings.Split(payload, ".")
+	if len(parts) != 2 {
+		return "", errMalformedToken
+	}
+
+	expiresAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", errMalformedToken
+	}
+
+	if time.Now().Unix() >= expiresAt {
+		return "", errExpiredToken
+	}
+
+	value, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return "", errMalformedToken
+	}
+
+	return string(value), nil
+}
+
+func (provider *HmacProvider) mac(payload string) []byte {
+	mac := hmac.New(sha256.New, []byte(provider.configuration.Auth.SecretKey))
+	mac.Write([]byte(payload))
+
+	return mac.Sum(nil)
+}
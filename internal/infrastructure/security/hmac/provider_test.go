@@ -0,0 +1,171 @@
+package hmac_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/waliqueiroz/letmeask-api/internal/infrastructure/configurations"
+	hmacProvider "github.com/waliqueiroz/letmeask-api/internal/infrastructure/security/hmac"
+)
+
+const secretKey = "secret"
+
+// signPayload signs a payload the way the provider does, so tokens the
+// provider would never produce can still carry a valid signature.
+func signPayload(payload string) string {
+	mac := hmac.New(sha256.New, []byte(secretKey))
+	mac.Write([]byte(payload))
+
+	return payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func encode(value string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(value))
+}
+
+var _ = Describe("HmacProvider", func() {
+	var provider *hmacProvider.HmacProvider
+
+	value := "room:621f5ec1e07fdbb81c8221f7:6117e377b6e7bae09f52c483"
+	expiresAt := time.Now().Add(time.Hour)
+
+	BeforeEach(func() {
+		provider = hmacProvider.NewHmacProvider(configurations.Configuration{
+			Auth: configurations.Auth{SecretKey: secretKey},
+		})
+	})
+
+	Describe("Verify", func() {
+		var token string
+		var result string
+		var verifyError error
+
+		JustBeforeEach(func() {
+			result, verifyError = provider.Verify(token)
+		})
+
+		When("the token was signed and has not expired", func() {
+			BeforeEach(func() {
+				token = provider.Sign(value, expiresAt)
+			})
+
+			It("result should be the signed value", func() {
+				Expect(result).To(Equal(value))
+			})
+
+			It("error should be nil", func() {
+				Expect(verifyError).Should(BeNil())
+			})
+		})
+
+		When("the token has expired", func() {
+			BeforeEach(func() {
+				token = provider.Sign(value, time.Now().Add(-time.Second))
+			})
+
+			It("result should be empty", func() {
+				Expect(result).To(BeEmpty())
+			})
+
+			It("error should be an expired token error", func() {
+				Expect(verifyError).To(MatchError("expired token"))
+			})
+		})
+
+		When("the signed value was tampered with", func() {
+			BeforeEach(func() {
+				parts := strings.Split(provider.Sign(value, expiresAt), ".")
+				parts[0] = encode("room:621f5ec1e07fdbb81c8221f7:621f5f40e07fdbb81c8221f8")
+				token = strings.Join(parts, ".")
+			})
+
+			It("result should be empty", func() {
+				Expect(result).To(BeEmpty())
+			})
+
+			It("error should be an invalid signature error", func() {
+				Expect(verifyError).To(MatchError("invalid signature"))
+			})
+		})
+
+		When("the expiration was tampered with", func() {
+			BeforeEach(func() {
+				parts := strings.Split(provider.Sign(value, time.Now().Add(-time.Second)), ".")
+				parts[1] = strconv.FormatInt(expiresAt.Unix(), 10)
+				token = strings.Join(parts, ".")
+			})
+
+			It("error should be an invalid signature error", func() {
+				Expect(verifyError).To(MatchError("invalid signature"))
+			})
+		})
+
+		When("the token was signed with another key", func() {
+			BeforeEach(func() {
+				token = hmacProvider.NewHmacProvider(configurations.Configuration{
+					Auth: configurations.Auth{SecretKey: "another secret"},
+				}).Sign(value, expiresAt)
+			})
+
+			It("error should be an invalid signature error", func() {
+				Expect(verifyError).To(MatchError("invalid signature"))
+			})
+		})
+
+		When("the token has no signature", func() {
+			BeforeEach(func() {
+				token = "token"
+			})
+
+			It("error should be a malformed token error", func() {
+				Expect(verifyError).To(MatchError("malformed token"))
+			})
+		})
+
+		When("the signature is not base64 encoded", func() {
+			BeforeEach(func() {
+				token = encode(value) + "." + strconv.FormatInt(expiresAt.Unix(), 10) + ".not*base64"
+			})
+
+			It("error should be a malformed token error", func() {
+				Expect(verifyError).To(MatchError("malformed token"))
+			})
+		})
+
+		When("the signed payload has no expiration", func() {
+			BeforeEach(func() {
+				token = signPayload(encode(value))
+			})
+
+			It("error should be a malformed token error", func() {
+				Expect(verifyError).To(MatchError("malformed token"))
+			})
+		})
+
+		When("the signed expiration is not a number", func() {
+			BeforeEach(func() {
+				token = signPayload(encode(value) + ".tomorrow")
+			})
+
+			It("error should be a malformed token error", func() {
+				Expect(verifyError).To(MatchError("malformed token"))
+			})
+		})
+
+		When("the signed value is not base64 encoded", func() {
+			BeforeEach(func() {
+				token = signPayload("not*base64." + strconv.FormatInt(expiresAt.Unix(), 10))
+			})
+
+			It("error should be a malformed token error", func() {
+				Expect(verifyError).To(MatchError("malformed token"))
+			})
+		})
+	})
+})